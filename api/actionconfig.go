@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/fatih/structs"
 	"github.com/imdario/mergo"
@@ -18,6 +19,8 @@ type ActionConfig struct {
 	MinSoc     *int        `mapstructure:"minSoc,omitempty"`     // Minimum Soc
 	TargetSoc  *int        `mapstructure:"targetSoc,omitempty"`  // Target Soc
 	Priority   *int        `mapstructure:"priority,omitempty"`   // Priority
+
+	Precondition *time.Duration `mapstructure:"precondition,omitempty"` // Climate preconditioning before target time
 }
 
 // Merge merges all non-nil properties of the additional config into the base config.
@@ -80,6 +80,12 @@ type BatteryCapacity interface {
 	Capacity() float64
 }
 
+// Curtailer limits the active power output of a pv inverter in W.
+// Negative limits release the curtailment.
+type Curtailer interface {
+	Curtail(limit float64) error
+}
+
 // ChargeState provides current charging status
 type ChargeState interface {
 	Status() (ChargeStatus, error)
@@ -161,6 +167,11 @@ type VehicleClimater interface {
 	Climater() (bool, error)
 }
 
+// VehicleClimateController starts or stops the vehicles climatisation
+type VehicleClimateController interface {
+	Climatize(enable bool) error
+}
+
 // VehicleOdometer returns the vehicles milage
 type VehicleOdometer interface {
 	Odometer() (float64, error)
@@ -176,6 +187,11 @@ type SocLimiter interface {
 	TargetSoc() (float64, error)
 }
 
+// SocLimitSetter sets the vehicles charge limit
+type SocLimitSetter interface {
+	SetTargetSoc(soc int64) error
+}
+
 // VehicleChargeController allows to start/stop the charging session on the vehicle side
 type VehicleChargeController interface {
 	StartCharge() error
@@ -502,6 +502,24 @@ func (c *EEBus) Soc() (float64, error) {
 	return soc, nil
 }
 
+var _ api.BatteryCapacity = (*EEBus)(nil)
+
+// Capacity implements the api.BatteryCapacity interface.
+// Capacity is derived from the vehicle's energy demand until full and its soc.
+func (c *EEBus) Capacity() float64 {
+	soc, err := c.Soc()
+	if err != nil || soc >= 100 {
+		return 0
+	}
+
+	demand, err := c.emobility.EVEnergyDemand()
+	if err != nil || demand.MaxDemand <= 0 {
+		return 0
+	}
+
+	return demand.MaxDemand / (1 - soc/100) / 1e3
+}
+
 var _ loadpoint.Controller = (*EEBus)(nil)
 
 // LoadpointControl implements loadpoint.Controller
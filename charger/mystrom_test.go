@@ -0,0 +1,39 @@
+package charger
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMyStromReplay(t *testing.T) {
+	test.Replay(t, "testdata/mystrom.jsonl")
+
+	wb, err := NewMyStromFromConfig(map[string]interface{}{
+		"uri":          "192.0.2.10",
+		"standbypower": 15,
+		"cache":        0,
+	})
+	require.NoError(t, err)
+
+	enabled, err := wb.Enabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	require.NoError(t, wb.Enable(true))
+
+	enabled, err = wb.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	power, err := wb.(api.Meter).CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 2300.5, power)
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+}
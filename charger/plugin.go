@@ -0,0 +1,105 @@
+package charger
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/plugin"
+)
+
+// Plugin is an api.Charger implementation provided by an out-of-process plugin
+type Plugin struct {
+	*embed
+	statusG     func() (api.ChargeStatus, error)
+	enabledG    func() (bool, error)
+	enableS     func(bool) error
+	maxCurrentS func(int64) error
+}
+
+func init() {
+	registry.Add("plugin", NewPluginFromConfig)
+}
+
+//go:generate go run ../cmd/tools/decorate.go -f decoratePlugin -b *Plugin -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseSwitcher,Phases1p3p,func(phases int) error" -t "api.Identifier,Identify,func() (string, error)" -t "api.ChargeRater,ChargedEnergy,func() (float64, error)" -t "api.Battery,Soc,func() (float64, error)"
+
+// NewPluginFromConfig creates a plugin charger from generic config
+func NewPluginFromConfig(other map[string]interface{}) (api.Charger, error) {
+	var cc struct {
+		embed         `mapstructure:",squash"`
+		plugin.Config `mapstructure:",squash"`
+		Other         map[string]string `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	client, err := plugin.NewClient("charger", cc.Config, cc.Other)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Plugin{
+		embed:       &cc.embed,
+		statusG:     client.StatusGetter(),
+		enabledG:    client.BoolGetter(client.Enabled),
+		enableS:     client.BoolSetter(client.Enable),
+		maxCurrentS: client.IntSetter(client.MaxCurrent),
+	}
+
+	var currentPower, totalEnergy, chargedEnergy func() (float64, error)
+	if client.Has(plugin.Meter) {
+		currentPower = client.FloatGetter(client.CurrentPower)
+	}
+	if client.Has(plugin.MeterEnergy) {
+		totalEnergy = client.FloatGetter(client.TotalEnergy)
+	}
+	if client.Has(plugin.ChargeRater) {
+		chargedEnergy = client.FloatGetter(client.ChargedEnergy)
+	}
+
+	var currents func() (float64, float64, float64, error)
+	if client.Has(plugin.PhaseCurrents) {
+		currents = client.PhaseGetter(client.Currents)
+	}
+
+	var phases1p3p func(int) error
+	if client.Has(plugin.PhaseSwitcher) {
+		phasesS := client.IntSetter(client.Phases1p3p)
+		phases1p3p = func(phases int) error {
+			return phasesS(int64(phases))
+		}
+	}
+
+	var identify func() (string, error)
+	if client.Has(plugin.Identifier) {
+		identify = client.StringGetter(client.Identify)
+	}
+
+	// vehicle soc reported by charger
+	var soc func() (float64, error)
+	if client.Has(plugin.Battery) {
+		soc = client.FloatGetter(client.Soc)
+	}
+
+	return decoratePlugin(c, currentPower, totalEnergy, currents, phases1p3p, identify, chargedEnergy, soc), nil
+}
+
+// Status implements the api.Charger interface
+func (c *Plugin) Status() (api.ChargeStatus, error) {
+	return c.statusG()
+}
+
+// Enabled implements the api.Charger interface
+func (c *Plugin) Enabled() (bool, error) {
+	return c.enabledG()
+}
+
+// Enable implements the api.Charger interface
+func (c *Plugin) Enable(enable bool) error {
+	return c.enableS(enable)
+}
+
+// MaxCurrent implements the api.Charger interface
+func (c *Plugin) MaxCurrent(current int64) error {
+	return c.maxCurrentS(current)
+}
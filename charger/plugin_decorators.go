@@ -0,0 +1,2753 @@
+package charger
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decoratePlugin(base *Plugin, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseSwitcher func(phases int) error, identifier func() (string, error), chargeRater func() (float64, error), battery func() (float64, error)) api.Charger {
+	switch {
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return base
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Meter
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery != nil && chargeRater != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil:
+		return &struct {
+			*Plugin
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Plugin: base,
+			Battery: &decoratePluginBatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decoratePluginChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decoratePluginIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decoratePluginMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decoratePluginMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decoratePluginPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decoratePluginPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decoratePluginBatteryImpl struct {
+	battery func() (float64, error)
+}
+
+func (impl *decoratePluginBatteryImpl) Soc() (float64, error) {
+	return impl.battery()
+}
+
+type decoratePluginChargeRaterImpl struct {
+	chargeRater func() (float64, error)
+}
+
+func (impl *decoratePluginChargeRaterImpl) ChargedEnergy() (float64, error) {
+	return impl.chargeRater()
+}
+
+type decoratePluginIdentifierImpl struct {
+	identifier func() (string, error)
+}
+
+func (impl *decoratePluginIdentifierImpl) Identify() (string, error) {
+	return impl.identifier()
+}
+
+type decoratePluginMeterImpl struct {
+	meter func() (float64, error)
+}
+
+func (impl *decoratePluginMeterImpl) CurrentPower() (float64, error) {
+	return impl.meter()
+}
+
+type decoratePluginMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decoratePluginMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
+
+type decoratePluginPhaseCurrentsImpl struct {
+	phaseCurrents func() (float64, float64, float64, error)
+}
+
+func (impl *decoratePluginPhaseCurrentsImpl) Currents() (float64, float64, float64, error) {
+	return impl.phaseCurrents()
+}
+
+type decoratePluginPhaseSwitcherImpl struct {
+	phaseSwitcher func(phases int) error
+}
+
+func (impl *decoratePluginPhaseSwitcherImpl) Phases1p3p(phases int) error {
+	return impl.phaseSwitcher(phases)
+}
@@ -0,0 +1,31 @@
+package charger
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/util"
+)
+
+func init() {
+	registry.Add("simulator", NewSimulatorFromConfig)
+}
+
+// NewSimulatorFromConfig creates a simulated charger from generic config
+func NewSimulatorFromConfig(other map[string]interface{}) (api.Charger, error) {
+	cc := struct {
+		Model   string        // shared simulation model
+		Vehicle string        // id of the plugged-in simulated vehicle
+		Phases  int           // physically connected phases
+		Delay   time.Duration // ramp delay until setpoints become effective
+	}{
+		Delay: 5 * time.Second,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	return simulator.Instance(cc.Model).AddCharger(cc.Vehicle, cc.Phases, cc.Delay), nil
+}
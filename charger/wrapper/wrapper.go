@@ -0,0 +1,85 @@
+package wrapper
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Wrapper wraps an api.Charger to capture initialization errors.
+// The charger is re-created in the background and swapped in once available.
+// Only the basic charger interface is delegated. Consumers pick up optional
+// charger features by replacing the wrapper with the live charger once available.
+type Wrapper struct {
+	mu      sync.RWMutex
+	charger api.Charger
+	online  func()
+	err     error
+}
+
+// New creates a new Charger that is re-created using factory until it succeeds.
+// The optional online callback is invoked once the charger has become available.
+func New(name string, err error, factory func() (api.Charger, error), online func()) *Wrapper {
+	c := &Wrapper{
+		online: online,
+		err:    fmt.Errorf("charger not available: %w", err),
+	}
+
+	util.Reinit(util.NewLogger(name), factory, c.setCharger)
+
+	return c
+}
+
+// setCharger swaps in the live charger
+func (c *Wrapper) setCharger(charger api.Charger) {
+	c.mu.Lock()
+	c.charger = charger
+	c.mu.Unlock()
+
+	if c.online != nil {
+		c.online()
+	}
+}
+
+// Live returns the live charger or nil if still offline
+func (c *Wrapper) Live() api.Charger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.charger
+}
+
+var _ api.Charger = (*Wrapper)(nil)
+
+// Status implements the api.Charger interface
+func (c *Wrapper) Status() (api.ChargeStatus, error) {
+	if cc := c.Live(); cc != nil {
+		return cc.Status()
+	}
+	return api.StatusNone, c.err
+}
+
+// Enabled implements the api.Charger interface
+func (c *Wrapper) Enabled() (bool, error) {
+	if cc := c.Live(); cc != nil {
+		return cc.Enabled()
+	}
+	return false, c.err
+}
+
+// Enable implements the api.Charger interface
+func (c *Wrapper) Enable(enable bool) error {
+	if cc := c.Live(); cc != nil {
+		return cc.Enable(enable)
+	}
+	return c.err
+}
+
+// MaxCurrent implements the api.Charger interface
+func (c *Wrapper) MaxCurrent(current int64) error {
+	if cc := c.Live(); cc != nil {
+		return cc.MaxCurrent(current)
+	}
+	return c.err
+}
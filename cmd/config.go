@@ -4,18 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/charger"
+	chargerwrapper "github.com/evcc-io/evcc/charger/wrapper"
+	"github.com/evcc-io/evcc/core/quota"
+	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/meter"
+	meterwrapper "github.com/evcc-io/evcc/meter/wrapper"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
@@ -70,6 +77,7 @@ type config struct {
 	Meters       []qualifiedConfig
 	Chargers     []qualifiedConfig
 	Vehicles     []qualifiedConfig
+	Quotas       map[string]quota.Limit
 	Tariffs      tariffConfig
 	Site         map[string]interface{}
 	Loadpoints   []map[string]interface{}
@@ -112,8 +120,14 @@ type typedConfig struct {
 }
 
 type messagingConfig struct {
-	Events   map[string]push.EventTemplateConfig
-	Services []typedConfig
+	Events     map[string]push.EventTemplateConfig
+	Services   []typedConfig
+	Recipients []recipientConfig
+}
+
+type recipientConfig struct {
+	push.RecipientConfig `mapstructure:",squash"`
+	Services             []typedConfig
 }
 
 type tariffConfig struct {
@@ -147,6 +161,7 @@ type ConfigProvider struct {
 	vehicles map[string]api.Vehicle
 	visited  map[string]bool
 	auth     *util.AuthCollection
+	events   chan push.Event
 }
 
 func (cp *ConfigProvider) TrackVisitors() {
@@ -185,6 +200,74 @@ func (cp *ConfigProvider) Vehicle(name string) (api.Vehicle, error) {
 	return nil, fmt.Errorf("vehicle does not exist: %s", name)
 }
 
+// vehicleBrand returns the vehicle's brand from type or template
+func vehicleBrand(cc qualifiedConfig) string {
+	if tmpl, ok := cc.Other["template"].(string); ok && cc.Type == "template" {
+		return tmpl
+	}
+	return cc.Type
+}
+
+// vehicleAccount returns the vehicle's api account, falling back to the vehicle name
+func vehicleAccount(cc qualifiedConfig) string {
+	if user, ok := cc.Other["user"].(string); ok && user != "" {
+		return user
+	}
+	return cc.Name
+}
+
+const vehicleDefaultCache = 15 * time.Minute // default vehicle api cache duration
+
+// vehicleCache returns the vehicle's api cache duration, falling back to the default vehicle cache
+func vehicleCache(cc qualifiedConfig) time.Duration {
+	var res struct {
+		Cache time.Duration
+		Other map[string]interface{} `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(cc.Other, &res); err != nil || res.Cache <= 0 {
+		return vehicleDefaultCache
+	}
+
+	return res.Cache
+}
+
+// isNetworkError checks if device creation failed due to a temporary network problem
+func isNetworkError(err error) bool {
+	var ne net.Error
+	return errors.As(err, &ne) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EHOSTUNREACH) || errors.Is(err, syscall.ENETUNREACH) ||
+		errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF)
+}
+
+// isTemporaryError checks if vehicle creation failed for other reasons than invalid configuration
+func isTemporaryError(err error) bool {
+	var ce *util.ConfigError
+	return !errors.As(err, &ce)
+}
+
+// deviceOnline returns a callback announcing that a device has become available after re-initialization
+func (cp *ConfigProvider) deviceOnline(class, name string) func() {
+	return func() {
+		log.INFO.Printf("%s %s: online", class, name)
+
+		select {
+		case cp.events <- push.Event{Event: "online", Attributes: map[string]interface{}{
+			"device": name,
+			"class":  class,
+		}}:
+		default:
+		}
+	}
+}
+
+// forwardEvents passes device events to the push channel
+func (cp *ConfigProvider) forwardEvents(pushChan chan<- push.Event) {
+	for ev := range cp.events {
+		pushChan <- ev
+	}
+}
+
 func (cp *ConfigProvider) configure(conf config) error {
 	err := cp.configureMeters(conf)
 	if err == nil {
@@ -196,17 +279,50 @@ func (cp *ConfigProvider) configure(conf config) error {
 	return err
 }
 
+// gridMeterRef returns the name of the site's grid meter
+func gridMeterRef(conf config) string {
+	var cc struct {
+		Meters map[string]interface{}
+		Other  map[string]interface{} `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(conf.Site, &cc); err != nil {
+		return ""
+	}
+
+	ref, _ := cc.Meters["grid"].(string)
+	return ref
+}
+
 func (cp *ConfigProvider) configureMeters(conf config) error {
+	grid := gridMeterRef(conf)
+
 	cp.meters = make(map[string]api.Meter)
 	for id, cc := range conf.Meters {
 		if cc.Name == "" {
 			return fmt.Errorf("cannot create %s meter: missing name", humanize.Ordinal(id+1))
 		}
 
-		m, err := meter.NewFromConfig(cc.Type, cc.Other)
+		cc := cc
+		factory := func() (api.Meter, error) {
+			return meter.NewFromConfig(cc.Type, cc.Other)
+		}
+
+		// push-based grid meter updates trigger event-driven site updates
+		if cc.Name == grid {
+			factory = util.NotifyUpdates(factory)
+		}
+
+		// fail fast, meters are created sequentially and retried in background
+		m, err := factory()
 		if err != nil {
-			err = fmt.Errorf("cannot create meter '%s': %w", cc.Name, err)
-			return err
+			if !isNetworkError(err) {
+				return fmt.Errorf("cannot create meter '%s': %w", cc.Name, err)
+			}
+
+			// wrap network errors and retry in background
+			log.ERROR.Printf("creating meter %s failed: %v", cc.Name, err)
+			m = meterwrapper.New(cc.Name, err, factory, cp.deviceOnline("meter", cc.Name))
 		}
 
 		if _, exists := cp.meters[cc.Name]; exists {
@@ -232,9 +348,19 @@ func (cp *ConfigProvider) configureChargers(conf config) error {
 		cc := cc
 
 		g.Go(func() error {
-			c, err := charger.NewFromConfig(cc.Type, cc.Other)
+			c, err := util.Retry(util.NewLogger(cc.Name), func() (api.Charger, error) {
+				return charger.NewFromConfig(cc.Type, cc.Other)
+			}, isNetworkError)
 			if err != nil {
-				return fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
+				if !isNetworkError(err) {
+					return fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
+				}
+
+				// wrap network errors and retry in background
+				log.ERROR.Printf("creating charger %s failed: %v", cc.Name, err)
+				c = chargerwrapper.New(cc.Name, err, func() (api.Charger, error) {
+					return charger.NewFromConfig(cc.Type, cc.Other)
+				}, cp.deviceOnline("charger", cc.Name))
 			}
 
 			mu.Lock()
@@ -264,17 +390,41 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 
 		cc := cc
 
-		g.Go(func() error {
+		// key learned battery model by config name
+		register := func(v api.Vehicle) {
+			if soc.Models != nil {
+				soc.Models.Register(v, cc.Name)
+			}
+		}
+
+		// live vehicles replacing offline vehicles are registered once created
+		factory := func() (api.Vehicle, error) {
 			v, err := vehicle.NewFromConfig(cc.Type, cc.Other)
 			if err != nil {
-				var ce *util.ConfigError
-				if errors.As(err, &ce) {
+				return nil, err
+			}
+
+			// schedule vehicle api requests within brand account quota
+			if quota.Instance != nil {
+				quota.Instance.Register(v, vehicleBrand(cc), vehicleAccount(cc), vehicleCache(cc))
+			}
+
+			register(v)
+
+			return v, nil
+		}
+
+		g.Go(func() error {
+			v, err := util.Retry(util.NewLogger(cc.Name), factory, isTemporaryError)
+			if err != nil {
+				if !isTemporaryError(err) {
 					return fmt.Errorf("cannot create vehicle '%s': %w", cc.Name, err)
 				}
 
 				// wrap non-config vehicle errors to prevent fatals
 				log.ERROR.Printf("creating vehicle %s failed: %v", cc.Name, err)
-				v = wrapper.New(cc.Name, cc.Other, err)
+				v = wrapper.NewWithFactory(cc.Name, cc.Other, err, factory, cp.deviceOnline("vehicle", cc.Name))
+				register(v)
 			}
 
 			// ensure vehicle config has title
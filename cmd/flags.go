@@ -11,6 +11,12 @@ const (
 	flagHeaders            = "log-headers"
 	flagHeadersDescription = "Log headers"
 
+	flagTrace            = "trace"
+	flagTraceDescription = "Record redacted device I/O to trace file"
+
+	flagReplay            = "replay"
+	flagReplayDescription = "Replay device I/O from trace file"
+
 	flagName            = "name"
 	flagNameDescription = "Select %s by name"
 
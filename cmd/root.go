@@ -15,6 +15,7 @@ import (
 	"github.com/evcc-io/evcc/core"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/server/db"
 	"github.com/evcc-io/evcc/server/modbus"
 	"github.com/evcc-io/evcc/server/updater"
 	"github.com/evcc-io/evcc/util"
@@ -59,6 +60,8 @@ func init() {
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "Help")
 
 	rootCmd.PersistentFlags().Bool(flagHeaders, false, flagHeadersDescription)
+	rootCmd.PersistentFlags().String(flagTrace, "", flagTraceDescription)
+	rootCmd.PersistentFlags().String(flagReplay, "", flagReplayDescription)
 
 	// config file options
 	rootCmd.PersistentFlags().StringP("log", "l", "info", "Log level (fatal, error, warn, info, debug, trace)")
@@ -191,9 +194,14 @@ func runRoot(cmd *cobra.Command, args []string) {
 		site, err = configureSiteAndLoadpoints(conf)
 	}
 
+	// setup history
+	if err == nil && db.Instance != nil {
+		err = configureHistory(tee.Attach())
+	}
+
 	// setup database
 	if err == nil && conf.Influx.URL != "" {
-		configureInflux(conf.Influx, site, pipe.NewDropper(append(ignoreErrors, ignoreEmpty)...).Pipe(tee.Attach()))
+		err = configureInflux(conf.Influx, site, pipe.NewDropper(append(ignoreErrors, ignoreEmpty)...).Pipe(tee.Attach()))
 	}
 
 	// setup mqtt publisher
@@ -215,7 +223,12 @@ func runRoot(cmd *cobra.Command, args []string) {
 	// setup messaging
 	var pushChan chan push.Event
 	if err == nil {
-		pushChan, err = configureMessengers(conf.Messaging, valueChan, cache)
+		pushChan, err = configureMessengers(conf.Messaging, site, valueChan, cache)
+	}
+
+	// announce re-initialized devices
+	if err == nil {
+		go cp.forwardEvents(pushChan)
 	}
 
 	// run shutdown functions on stop
@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -12,7 +13,10 @@ import (
 	"github.com/evcc-io/evcc/charger/eebus"
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/core/history"
+	"github.com/evcc-io/evcc/core/quota"
 	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/hems"
 	"github.com/evcc-io/evcc/provider/golang"
 	"github.com/evcc-io/evcc/provider/javascript"
@@ -28,6 +32,7 @@ import (
 	"github.com/evcc-io/evcc/util/pipe"
 	"github.com/evcc-io/evcc/util/request"
 	"github.com/evcc-io/evcc/util/sponsor"
+	"github.com/evcc-io/evcc/util/trace"
 	"github.com/libp2p/zeroconf/v2"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -36,7 +41,9 @@ import (
 	"golang.org/x/text/currency"
 )
 
-var cp = new(ConfigProvider)
+var cp = &ConfigProvider{
+	events: make(chan push.Event, 8), // device re-initialization events
+}
 
 func loadConfigFile(conf *config) error {
 	err := viper.ReadInConfig()
@@ -67,6 +74,11 @@ func configureEnvironment(cmd *cobra.Command, conf config) (err error) {
 		request.LogHeaders = true
 	}
 
+	// device i/o trace
+	if err = configureTrace(cmd); err != nil {
+		return err
+	}
+
 	// setup machine id
 	if conf.Plant != "" {
 		err = machine.CustomID(conf.Plant)
@@ -102,6 +114,11 @@ func configureEnvironment(cmd *cobra.Command, conf config) (err error) {
 		err = configureGo(conf.Go)
 	}
 
+	// setup vehicle api quota scheduler
+	if err == nil {
+		quota.Instance = quota.New(conf.Quotas)
+	}
+
 	// setup EEBus server
 	if err == nil && conf.EEBus != nil {
 		err = configureEEBus(conf.EEBus)
@@ -126,25 +143,49 @@ func configureDatabase(conf dbConfig) error {
 		}
 	})
 
+	// learned vehicle battery models
+	models, err := soc.NewStore(db.Instance)
+	if err != nil {
+		return err
+	}
+
+	soc.Models = models
+	shutdown.Register(models.Persist)
+
 	return nil
 }
 
 // configureInflux configures influx database
-func configureInflux(conf server.InfluxConfig, site site.API, in <-chan util.Param) {
-	influx := server.NewInfluxClient(
-		conf.URL,
-		conf.Token,
-		conf.Org,
-		conf.User,
-		conf.Password,
-		conf.Database,
-	)
+func configureInflux(conf server.InfluxConfig, site site.API, in <-chan util.Param) error {
+	influx, err := server.NewInfluxClient(conf)
+	if err != nil {
+		return fmt.Errorf("failed configuring influx: %w", err)
+	}
 
 	// eliminate duplicate values
 	dedupe := pipe.NewDeduplicator(30*time.Minute, "vehicleCapacity", "vehicleSoc", "vehicleRange", "vehicleOdometer", "chargedEnergy", "chargeRemainingEnergy")
 	in = dedupe.Pipe(in)
 
+	shutdown.Register(influx.Shutdown)
+
 	go influx.Run(site, in)
+
+	return nil
+}
+
+// configureHistory configures the built-in history store
+func configureHistory(in <-chan util.Param) error {
+	h, err := history.New(db.Instance)
+	if err != nil {
+		return fmt.Errorf("failed configuring history: %w", err)
+	}
+
+	history.Instance = h
+	shutdown.Register(h.Persist)
+
+	go h.Run(in)
+
+	return nil
 }
 
 // setup mqtt
@@ -222,7 +263,7 @@ func configureEEBus(conf map[string]interface{}) error {
 }
 
 // setup messaging
-func configureMessengers(conf messagingConfig, valueChan chan util.Param, cache *util.Cache) (chan push.Event, error) {
+func configureMessengers(conf messagingConfig, site site.API, valueChan chan util.Param, cache *util.Cache) (chan push.Event, error) {
 	messageChan := make(chan push.Event, 1)
 
 	messageHub, err := push.NewHub(conf.Events, cache)
@@ -238,6 +279,26 @@ func configureMessengers(conf messagingConfig, valueChan chan util.Param, cache
 		messageHub.Add(impl)
 	}
 
+	for _, rc := range conf.Recipients {
+		recipient, err := push.NewRecipient(rc.RecipientConfig)
+		if err != nil {
+			return messageChan, fmt.Errorf("failed configuring push recipient %s: %w", rc.Name, err)
+		}
+
+		for _, service := range rc.Services {
+			impl, err := push.NewFromConfig(service.Type, service.Other)
+			if err != nil {
+				return messageChan, fmt.Errorf("failed configuring push service %s for recipient %s: %w", service.Type, rc.Name, err)
+			}
+			recipient.Add(impl)
+		}
+
+		messageHub.AddRecipient(recipient)
+	}
+
+	messageHub.SiteControl(site)
+	push.Instance = messageHub
+
 	go messageHub.Run(messageChan, valueChan)
 
 	return messageChan, nil
@@ -340,3 +401,27 @@ func configureLoadpoints(conf config, cp *ConfigProvider) (loadpoints []*core.Lo
 
 	return loadpoints, nil
 }
+
+// configureTrace enables recording or replaying device i/o
+func configureTrace(cmd *cobra.Command) error {
+	if file := cmd.Flag(flagReplay).Value.String(); file != "" {
+		_, err := trace.Replay(file)
+		return err
+	}
+
+	if file := cmd.Flag(flagTrace).Value.String(); file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+
+		trace.Record(f)
+
+		shutdown.Register(func() {
+			trace.Stop()
+			f.Close()
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,579 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/evcc-io/evcc/core/history"
+	"github.com/evcc-io/evcc/core/scenario"
+	"github.com/evcc-io/evcc/server"
+	"github.com/evcc-io/evcc/server/db"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/util"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// simulateCmd represents the simulate command
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate site and loadpoint settings on recorded data",
+	Long: `Replays recorded pv and home power through the configured site and loadpoints using simulated chargers and vehicles.
+
+Recorded data is read from the built-in history, from InfluxDB or from a CSV file with columns
+timestamp, pv and either home or grid (optional charge and battery). Powers are in W.
+History older than the minute data retention is replayed at hourly resolution,
+InfluxDB data is replayed at minute resolution.
+Vehicle arrivals and departures are read from a CSV file with columns timestamp, loadpoint
+(starting at 1), event (arrive or depart) and optional soc.
+
+Settings can be changed for comparison using --set, e.g.
+  --set bufferSoc=80 --set lp.enable.threshold=-500 --set lp2.mode=now`,
+	Run: runSimulate,
+}
+
+const (
+	flagSimulateFrom            = "from"
+	flagSimulateTo              = "to"
+	flagSimulateCsv             = "csv"
+	flagSimulateInflux          = "influx"
+	flagSimulateEvents          = "events"
+	flagSimulateInterval        = "interval"
+	flagSimulateSet             = "set"
+	flagSimulateSoc             = "soc"
+	flagSimulateBatteryCapacity = "battery-capacity"
+	flagSimulateBatterySoc      = "battery-soc"
+	flagSimulateBatteryPower    = "battery-power"
+)
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	simulateCmd.Flags().String(flagSimulateFrom, "", "Start time (default start of recorded data)")
+	simulateCmd.Flags().String(flagSimulateTo, "", "End time (default end of recorded data)")
+	simulateCmd.Flags().String(flagSimulateCsv, "", "Recorded data CSV file (default built-in history)")
+	simulateCmd.Flags().Bool(flagSimulateInflux, false, "Read recorded data from InfluxDB (default built-in history)")
+	simulateCmd.Flags().String(flagSimulateEvents, "", "Vehicle arrival and departure CSV file (default always connected)")
+	simulateCmd.Flags().Duration(flagSimulateInterval, 30*time.Second, "Control interval")
+	simulateCmd.Flags().StringArray(flagSimulateSet, nil, "Change site (key=value) or loadpoint (lp.key=value, lp1.key=value) setting")
+	simulateCmd.Flags().Float64(flagSimulateSoc, 20, "Initial vehicle soc")
+	simulateCmd.Flags().Float64(flagSimulateBatteryCapacity, 0, "Simulated home battery capacity (kWh)")
+	simulateCmd.Flags().Float64(flagSimulateBatterySoc, 50, "Initial home battery soc")
+	simulateCmd.Flags().Float64(flagSimulateBatteryPower, 5000, "Home battery max charge and discharge power (W)")
+}
+
+func runSimulate(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf); err != nil {
+		fatal(err)
+	}
+
+	// simulation is verbose at info level
+	if !cmd.Flags().Lookup("log").Changed {
+		util.LogLevel("error", nil)
+	}
+
+	flags := cmd.Flags()
+
+	from, err := parseSimulateTime(flags.Lookup(flagSimulateFrom).Value.String())
+	if err != nil {
+		fatal(err)
+	}
+
+	to, err := parseSimulateTime(flags.Lookup(flagSimulateTo).Value.String())
+	if err != nil {
+		fatal(err)
+	}
+
+	var pv, home simulator.Series
+	if file := flags.Lookup(flagSimulateCsv).Value.String(); file != "" {
+		pv, home, err = readSimulateCsv(file)
+	} else if influx, _ := flags.GetBool(flagSimulateInflux); influx {
+		pv, home, err = readSimulateInflux(conf.Influx, from, to)
+	} else {
+		pv, home, err = readSimulateHistory(from, to)
+	}
+	if err != nil {
+		fatal(err)
+	}
+
+	if len(pv) == 0 {
+		fatal(errors.New("no recorded data"))
+	}
+
+	if from.IsZero() {
+		from = pv[0].Time
+	}
+	if to.IsZero() {
+		to = pv[len(pv)-1].Time
+	}
+	if !to.After(from) {
+		fatal(errors.New("invalid time range"))
+	}
+
+	// simulation must not touch the session database
+	db.Instance = nil
+
+	site := make(map[string]interface{})
+	for k, v := range conf.Site {
+		site[k] = v
+	}
+
+	loadpoints := make([]map[string]interface{}, 0, len(conf.Loadpoints))
+	for _, lp := range conf.Loadpoints {
+		other := make(map[string]interface{})
+		for k, v := range lp {
+			other[k] = v
+		}
+		loadpoints = append(loadpoints, other)
+	}
+
+	sets, _ := flags.GetStringArray(flagSimulateSet)
+	if err := applySimulateSettings(site, loadpoints, sets); err != nil {
+		fatal(err)
+	}
+
+	tariffs, err := configureTariffs(conf.Tariffs)
+	if err != nil {
+		fatal(err)
+	}
+
+	interval, _ := flags.GetDuration(flagSimulateInterval)
+	soc, _ := flags.GetFloat64(flagSimulateSoc)
+
+	s := scenario.Scenario{
+		Start:    from,
+		Duration: to.Sub(from),
+		Interval: interval,
+		Site:     site,
+		PV:       &simulator.Generator{Peak: 1, Profile: pv},
+		Home:     &simulator.Load{Profile: home},
+		Tariffs:  tariffs,
+	}
+
+	if capacity, _ := flags.GetFloat64(flagSimulateBatteryCapacity); capacity > 0 {
+		batterySoc, _ := flags.GetFloat64(flagSimulateBatterySoc)
+		power, _ := flags.GetFloat64(flagSimulateBatteryPower)
+		s.Battery = &simulator.Battery{Capacity: capacity, Soc: batterySoc, MaxPower: power}
+	}
+
+	events := flags.Lookup(flagSimulateEvents).Value.String()
+	if events != "" {
+		if s.Events, err = readSimulateEvents(events, from); err != nil {
+			fatal(err)
+		}
+	}
+
+	for _, lp := range loadpoints {
+		// simulated devices replace configured devices
+		delete(lp, "meter")
+		delete(lp, "vehicles")
+
+		s.Loadpoints = append(s.Loadpoints, scenario.Loadpoint{
+			Config: lp,
+			Vehicle: simulator.VehicleConfig{
+				Capacity:     vehicleCapacity(lp["vehicle"]),
+				Soc:          soc,
+				Disconnected: events != "",
+			},
+		})
+	}
+
+	res, err := s.Run()
+	if err != nil {
+		fatal(err)
+	}
+
+	printSimulateResult(os.Stdout, s, res, tariffs.Currency.String())
+}
+
+// parseSimulateTime parses a local date or timestamp
+func parseSimulateTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+		if ts, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return ts, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time: %s", s)
+}
+
+// readSimulateHistory reads recorded pv and home power from the history store
+func readSimulateHistory(from, to time.Time) (simulator.Series, simulator.Series, error) {
+	if conf.Database.Dsn == "" {
+		return nil, nil, errors.New("history requires database")
+	}
+
+	if from.IsZero() || to.IsZero() {
+		return nil, nil, errors.New("history requires --from and --to")
+	}
+
+	if err := db.NewInstance(conf.Database.Type, conf.Database.Dsn); err != nil {
+		return nil, nil, err
+	}
+
+	h, err := history.New(db.Instance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// minute data is only retained for a limited period
+	res := history.Minute
+	if time.Since(from) > history.MinuteRetention {
+		res = history.Hour
+	}
+
+	entries, err := h.Query(from, to, res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pv, home simulator.Series
+	for _, e := range entries {
+		pv = append(pv, simulator.Sample{Time: e.Timestamp, Value: e.PvPower})
+		home = append(home, simulator.Sample{Time: e.Timestamp, Value: e.HomePower})
+	}
+
+	return pv, home, nil
+}
+
+// readSimulateInflux reads recorded pv and home power from influx
+func readSimulateInflux(cc server.InfluxConfig, from, to time.Time) (simulator.Series, simulator.Series, error) {
+	if cc.URL == "" {
+		return nil, nil, errors.New("influx not configured")
+	}
+
+	if typ := strings.ToLower(cc.Type); typ != "" && typ != "influx" {
+		return nil, nil, fmt.Errorf("influx type cannot be queried: %s", cc.Type)
+	}
+
+	if from.IsZero() || to.IsZero() {
+		return nil, nil, errors.New("influx requires --from and --to")
+	}
+
+	// InfluxDB v1 compatibility
+	token := cc.Token
+	if token == "" && cc.User != "" {
+		token = fmt.Sprintf("%s:%s", cc.User, cc.Password)
+	}
+
+	client := influxdb2.NewClient(cc.URL, token)
+	defer client.Close()
+
+	res, err := client.QueryAPI(cc.Org).Query(context.Background(), simulateInfluxQuery(cc, from, to))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Close()
+
+	return parseSimulateInflux(res, cc.Schema)
+}
+
+// simulateInfluxQuery returns the query for per-minute site pv and home power
+func simulateInfluxQuery(cc server.InfluxConfig, from, to time.Time) string {
+	filter := `(r._measurement == "pvPower" or r._measurement == "homePower") and r._field == "value"`
+	if strings.EqualFold(cc.Schema, "device") {
+		filter = `r._measurement == "site" and (r._field == "pvPower" or r._field == "homePower")`
+	}
+
+	// per-meter pv power is tagged with id
+	return fmt.Sprintf(`from(bucket: %q)
+  |> range(start: %s, stop: %s)
+  |> filter(fn: (r) => %s and not exists r.id)
+  |> aggregateWindow(every: 1m, fn: mean, createEmpty: false)`,
+		cc.Database, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), filter)
+}
+
+// parseSimulateInflux parses the query result into pv and home power
+func parseSimulateInflux(res *api.QueryTableResult, schema string) (simulator.Series, simulator.Series, error) {
+	var pv, home simulator.Series
+
+	for res.Next() {
+		rec := res.Record()
+
+		val, ok := rec.Value().(float64)
+		if !ok {
+			continue
+		}
+
+		name := rec.Measurement()
+		if strings.EqualFold(schema, "device") {
+			name = rec.Field()
+		}
+
+		sample := simulator.Sample{Time: rec.Time().Local(), Value: val}
+
+		switch name {
+		case "pvPower":
+			pv = append(pv, sample)
+		case "homePower":
+			home = append(home, sample)
+		}
+	}
+
+	return pv, home, res.Err()
+}
+
+// readSimulateCsv reads recorded pv and home power from CSV file
+func readSimulateCsv(file string) (simulator.Series, simulator.Series, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	return parseSimulateCsv(f)
+}
+
+// parseSimulateCsv parses recorded power. Home power is derived from grid power if not recorded.
+func parseSimulateCsv(r io.Reader) (simulator.Series, simulator.Series, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil, errors.New("missing header")
+	}
+
+	cols := make(map[string]int)
+	for i, name := range records[0] {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range []string{"timestamp", "pv"} {
+		if _, ok := cols[name]; !ok {
+			return nil, nil, fmt.Errorf("missing column: %s", name)
+		}
+	}
+
+	_, homeOk := cols["home"]
+	if _, gridOk := cols["grid"]; !homeOk && !gridOk {
+		return nil, nil, errors.New("missing column: home or grid")
+	}
+
+	var pv, home simulator.Series
+
+	for line, rec := range records[1:] {
+		ts, err := parseSimulateTime(rec[cols["timestamp"]])
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line+2, err)
+		}
+
+		val := make(map[string]float64)
+		for name, i := range cols {
+			if name == "timestamp" || i >= len(rec) || rec[i] == "" {
+				continue
+			}
+
+			if val[name], err = strconv.ParseFloat(strings.TrimSpace(rec[i]), 64); err != nil {
+				return nil, nil, fmt.Errorf("line %d: %s: %w", line+2, name, err)
+			}
+		}
+
+		power := val["home"]
+		if !homeOk {
+			power = val["grid"] + val["pv"] + val["battery"] - val["charge"]
+		}
+
+		pv = append(pv, simulator.Sample{Time: ts, Value: val["pv"]})
+		home = append(home, simulator.Sample{Time: ts, Value: power})
+	}
+
+	return pv, home, nil
+}
+
+// readSimulateEvents reads vehicle arrivals and departures from CSV file
+func readSimulateEvents(file string, start time.Time) ([]scenario.Event, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseSimulateEvents(f, start)
+}
+
+// parseSimulateEvents parses vehicle arrivals and departures
+func parseSimulateEvents(r io.Reader, start time.Time) ([]scenario.Event, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []scenario.Event
+
+	for line, rec := range records {
+		// skip header
+		if line == 0 && strings.EqualFold(strings.TrimSpace(rec[0]), "timestamp") {
+			continue
+		}
+
+		if len(rec) < 3 {
+			return nil, fmt.Errorf("line %d: missing fields", line+1)
+		}
+
+		ts, err := parseSimulateTime(strings.TrimSpace(rec[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+1, err)
+		}
+
+		lp, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil || lp < 1 {
+			return nil, fmt.Errorf("line %d: invalid loadpoint: %s", line+1, rec[1])
+		}
+
+		at := ts.Sub(start)
+
+		switch event := strings.ToLower(strings.TrimSpace(rec[2])); event {
+		case "arrive":
+			var soc float64
+			if len(rec) > 3 {
+				if soc, err = strconv.ParseFloat(strings.TrimSpace(rec[3]), 64); err != nil {
+					return nil, fmt.Errorf("line %d: invalid soc: %w", line+1, err)
+				}
+			}
+			res = append(res, scenario.Arrive(at, lp-1, soc))
+
+		case "depart":
+			res = append(res, scenario.Depart(at, lp-1))
+
+		default:
+			return nil, fmt.Errorf("line %d: invalid event: %s", line+1, event)
+		}
+	}
+
+	return res, nil
+}
+
+var simulateLoadpointKey = regexp.MustCompile(`^lp(\d*)$`)
+
+// applySimulateSettings changes site or loadpoint configuration using key=value settings.
+// Keys are prefixed with lp for all loadpoints, lpN for a single loadpoint or site.
+func applySimulateSettings(site map[string]interface{}, loadpoints []map[string]interface{}, sets []string) error {
+	for _, set := range sets {
+		key, value, ok := strings.Cut(set, "=")
+		if !ok {
+			return fmt.Errorf("invalid setting: %s", set)
+		}
+
+		var val interface{}
+		if err := yaml.Unmarshal([]byte(value), &val); err != nil {
+			return fmt.Errorf("invalid setting: %s: %w", set, err)
+		}
+
+		// configuration keys are lowercase
+		path := strings.Split(strings.ToLower(key), ".")
+
+		targets := []map[string]interface{}{site}
+
+		if m := simulateLoadpointKey.FindStringSubmatch(path[0]); m != nil {
+			targets = loadpoints
+
+			if m[1] != "" {
+				id, _ := strconv.Atoi(m[1])
+				if id < 1 || id > len(loadpoints) {
+					return fmt.Errorf("invalid loadpoint: %s", path[0])
+				}
+				targets = loadpoints[id-1 : id]
+			}
+
+			path = path[1:]
+		} else if path[0] == "site" {
+			path = path[1:]
+		}
+
+		if len(path) == 0 || path[0] == "" {
+			return fmt.Errorf("invalid setting: %s", set)
+		}
+
+		for _, target := range targets {
+			setPath(target, path, val)
+		}
+	}
+
+	return nil
+}
+
+// setPath sets a nested configuration value
+func setPath(conf map[string]interface{}, path []string, val interface{}) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := conf[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			conf[key] = next
+		}
+		conf = next
+	}
+
+	conf[path[len(path)-1]] = val
+}
+
+// vehicleCapacity returns the capacity of the referenced vehicle if configured
+func vehicleCapacity(ref interface{}) float64 {
+	for _, cc := range conf.Vehicles {
+		if cc.Name != ref {
+			continue
+		}
+
+		for k, v := range cc.Other {
+			if strings.EqualFold(k, "capacity") {
+				switch v := v.(type) {
+				case int:
+					return float64(v)
+				case float64:
+					return v
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func printSimulateResult(w io.Writer, s scenario.Scenario, res *scenario.Result, currency string) {
+	const format = "2006-01-02 15:04"
+	fmt.Fprintf(w, "Simulated %s to %s\n\n", s.Start.Local().Format(format), s.Start.Add(s.Duration).Local().Format(format))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 4, ' ', 0)
+
+	fmt.Fprintln(tw, "Loadpoint\tCharged\tSoc\tCycles")
+	for id, lp := range res.Loadpoints {
+		title, _ := s.Loadpoints[id].Config["title"].(string)
+		if title == "" {
+			title = fmt.Sprintf("lp%d", id+1)
+		}
+		fmt.Fprintf(tw, "%s\t%.1f kWh\t%.0f%%\t%d\n", title, lp.ChargedEnergy, lp.Soc, res.Cycles(id))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w)
+
+	fmt.Fprintf(tw, "PV\t%.1f kWh\n", res.PVEnergy)
+	fmt.Fprintf(tw, "Grid import\t%.1f kWh\n", res.GridImport)
+	fmt.Fprintf(tw, "Grid export\t%.1f kWh\n", res.GridExport)
+	fmt.Fprintf(tw, "Self-consumption\t%.0f%%\n", 100*res.SelfConsumption())
+	if res.Uncovered {
+		fmt.Fprintln(tw, "Cost\tn/a (tariffs do not cover the simulated period)")
+	} else {
+		fmt.Fprintf(tw, "Cost\t%.2f %s\n", res.Cost, currency)
+	}
+	tw.Flush()
+}
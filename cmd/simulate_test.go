@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateCsv(t *testing.T) {
+	pv, home, err := parseSimulateCsv(strings.NewReader(`timestamp,pv,grid,charge
+2023-06-01 12:00,5000,-1000,3000
+2023-06-01 12:15,4000,500,
+`))
+	require.NoError(t, err)
+	require.Len(t, pv, 2)
+
+	assert.Equal(t, 5000.0, pv[0].Value)
+	assert.Equal(t, 1000.0, home[0].Value)
+	assert.Equal(t, 4500.0, home[1].Value)
+	assert.Equal(t, 15*time.Minute, pv[1].Time.Sub(pv[0].Time))
+
+	_, _, err = parseSimulateCsv(strings.NewReader("timestamp,pv\n"))
+	assert.Error(t, err)
+}
+
+func TestSimulateInflux(t *testing.T) {
+	res := api.NewQueryTableResult(io.NopCloser(strings.NewReader(`#datatype,string,long,dateTime:RFC3339,double,string,string
+#group,false,false,false,false,true,true
+#default,_result,,,,,
+,result,table,_time,_value,_field,_measurement
+,,0,2023-06-01T12:01:00Z,5000,value,pvPower
+,,0,2023-06-01T12:02:00Z,4000,value,pvPower
+,,1,2023-06-01T12:01:00Z,1000,value,homePower
+
+`)))
+
+	pv, home, err := parseSimulateInflux(res, "")
+	require.NoError(t, err)
+	require.Len(t, pv, 2)
+	require.Len(t, home, 1)
+
+	assert.Equal(t, 4000.0, pv[1].Value)
+	assert.Equal(t, 1000.0, home[0].Value)
+	assert.Equal(t, time.Minute, pv[1].Time.Sub(pv[0].Time))
+}
+
+func TestSimulateEvents(t *testing.T) {
+	start := time.Date(2023, 6, 1, 0, 0, 0, 0, time.Local)
+
+	events, err := parseSimulateEvents(strings.NewReader(`timestamp,loadpoint,event,soc
+2023-06-01 08:00,2,arrive,30
+2023-06-01 17:00,2,depart
+`), start)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, 8*time.Hour, events[0].At)
+	assert.Equal(t, 1, events[0].Loadpoint)
+
+	_, err = parseSimulateEvents(strings.NewReader("2023-06-01 08:00,0,arrive\n"), start)
+	assert.Error(t, err)
+}
+
+func TestSimulateSettings(t *testing.T) {
+	site := map[string]interface{}{"buffersoc": 50}
+	loadpoints := []map[string]interface{}{
+		{"mode": "pv"},
+		{"mode": "pv", "enable": map[string]interface{}{"delay": "1m"}},
+	}
+
+	require.NoError(t, applySimulateSettings(site, loadpoints, []string{
+		"bufferSoc=80",
+		"lp.enable.threshold=-500",
+		"lp2.mode=now",
+	}))
+
+	assert.Equal(t, 80, site["buffersoc"])
+	assert.Equal(t, map[string]interface{}{"threshold": -500}, loadpoints[0]["enable"])
+	assert.Equal(t, map[string]interface{}{"delay": "1m", "threshold": -500}, loadpoints[1]["enable"])
+	assert.Equal(t, "pv", loadpoints[0]["mode"])
+	assert.Equal(t, "now", loadpoints[1]["mode"])
+
+	assert.Error(t, applySimulateSettings(site, loadpoints, []string{"lp3.mode=now"}))
+	assert.Error(t, applySimulateSettings(site, loadpoints, []string{"mode"}))
+}
@@ -23,7 +23,22 @@ type dynamicType struct {
 }
 
 type typeStruct struct {
-	Type, ShortType, Signature, Function, VarName string
+	Type, ShortType, Signature, Function, VarName, Params string
+}
+
+// params returns the comma-separated parameter names of a function signature like func(a int, b string) error
+func params(signature string) string {
+	args := strings.TrimPrefix(signature, "func(")
+	args = args[:strings.Index(args, ")")]
+
+	var res []string
+	for _, arg := range strings.Split(args, ",") {
+		if name := strings.Fields(arg); len(name) > 0 {
+			res = append(res, name[0])
+		}
+	}
+
+	return strings.Join(res, ", ")
 }
 
 func generate(out io.Writer, packageName, functionName, baseType string, dynamicTypes ...dynamicType) error {
@@ -79,6 +94,7 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 			VarName:   strings.ToLower(parts[1][:1]) + parts[1][1:],
 			Signature: dt.signature,
 			Function:  dt.function,
+			Params:    params(dt.signature),
 		}
 
 		combos = append(combos, dt.typ)
@@ -14,8 +14,10 @@ const (
 	vehicleIcon            = "vehicleIcon"            // vehicle icon for ui
 	vehicleOdometer        = "vehicleOdometer"        // vehicle odometer
 	vehiclePresent         = "vehiclePresent"         // vehicle detected
+	vehicleQuota           = "vehicleQuota"           // vehicle api requests remaining in quota interval
 	vehicleRange           = "vehicleRange"           // vehicle range
 	vehicleSoc             = "vehicleSoc"             // vehicle soc
+	vehicleSocEstimated    = "vehicleSocEstimated"    // vehicle soc estimated from charged energy only
 	vehicleTargetSoc       = "vehicleTargetSoc"       // vehicle soc limit
 	vehicleTitle           = "vehicleTitle"           // vehicle title
 
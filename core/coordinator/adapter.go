@@ -35,3 +35,12 @@ func (a *adapter) IdentifyVehicleByStatus() api.Vehicle {
 	available := a.c.availableDetectibleVehicles(a.lp)
 	return a.c.identifyVehicleByStatus(available)
 }
+
+func (a *adapter) IdentifyVehicleBySignature(sig Signature) (api.Vehicle, float64) {
+	available := a.c.availableVehicles(a.lp)
+	return a.c.identifyVehicleBySignature(a.lp, available, sig)
+}
+
+func (a *adapter) ResetSignature() {
+	a.c.resetSignature(a.lp)
+}
@@ -8,4 +8,6 @@ type API interface {
 	Acquire(api.Vehicle)
 	Release(api.Vehicle)
 	IdentifyVehicleByStatus() api.Vehicle
+	IdentifyVehicleBySignature(Signature) (api.Vehicle, float64)
+	ResetSignature()
 }
@@ -1,6 +1,8 @@
 package coordinator
 
 import (
+	"errors"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/util"
@@ -8,17 +10,21 @@ import (
 
 // Coordinator coordinates vehicle access between loadpoints
 type Coordinator struct {
-	log      *util.Logger
-	vehicles []api.Vehicle
-	tracked  map[api.Vehicle]loadpoint.API
+	log       *util.Logger
+	vehicles  []api.Vehicle
+	tracked   map[api.Vehicle]loadpoint.API
+	baselines map[api.Vehicle]baseline // soc baselines for signature identification
+	socScores map[api.Vehicle]float64  // last soc signature scores
 }
 
 // New creates a coordinator for a set of vehicles
 func New(log *util.Logger, vehicles []api.Vehicle) *Coordinator {
 	return &Coordinator{
-		log:      log,
-		vehicles: vehicles,
-		tracked:  make(map[api.Vehicle]loadpoint.API),
+		log:       log,
+		vehicles:  vehicles,
+		tracked:   make(map[api.Vehicle]loadpoint.API),
+		baselines: make(map[api.Vehicle]baseline),
+		socScores: make(map[api.Vehicle]float64),
 	}
 }
 
@@ -26,6 +32,23 @@ func (c *Coordinator) GetVehicles() []api.Vehicle {
 	return c.vehicles
 }
 
+// Replace replaces a vehicle, e.g. once a vehicle that failed to initialize has become available
+func (c *Coordinator) Replace(old, vehicle api.Vehicle) {
+	for i, v := range c.vehicles {
+		if v == old {
+			c.vehicles[i] = vehicle
+		}
+	}
+
+	if o, ok := c.tracked[old]; ok {
+		delete(c.tracked, old)
+		c.tracked[vehicle] = o
+	}
+
+	delete(c.baselines, old)
+	delete(c.socScores, old)
+}
+
 func (c *Coordinator) acquire(owner loadpoint.API, vehicle api.Vehicle) {
 	if o, ok := c.tracked[vehicle]; ok && o != owner {
 		o.SetVehicle(nil)
@@ -37,6 +60,19 @@ func (c *Coordinator) release(vehicle api.Vehicle) {
 	delete(c.tracked, vehicle)
 }
 
+// availableVehicles is the list of vehicles that are currently not associated to another loadpoint
+func (c *Coordinator) availableVehicles(owner loadpoint.API) []api.Vehicle {
+	var res []api.Vehicle
+
+	for _, vv := range c.vehicles {
+		if o, ok := c.tracked[vv]; o == owner || !ok {
+			res = append(res, vv)
+		}
+	}
+
+	return res
+}
+
 // availableDetectibleVehicles is the list of vehicles that are currently not
 // associated to another loadpoint and have a status api that allows for detection
 func (c *Coordinator) availableDetectibleVehicles(owner loadpoint.API) []api.Vehicle {
@@ -62,7 +98,9 @@ func (c *Coordinator) identifyVehicleByStatus(available []api.Vehicle) api.Vehic
 		if vs, ok := vehicle.(api.ChargeState); ok {
 			status, err := vs.Status()
 			if err != nil {
-				c.log.ERROR.Println("vehicle status:", err)
+				if !errors.Is(err, api.ErrNotAvailable) {
+					c.log.ERROR.Println("vehicle status:", err)
+				}
 				continue
 			}
 
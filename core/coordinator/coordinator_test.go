@@ -8,6 +8,7 @@ import (
 	"github.com/evcc-io/evcc/mock"
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestVehicleDetectByStatus(t *testing.T) {
@@ -65,3 +66,54 @@ func TestVehicleDetectByStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestVehicleDetectBySignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v1 := mock.NewMockVehicle(ctrl)
+	v2 := mock.NewMockVehicle(ctrl)
+
+	maxCurrent := 16.0
+
+	v1.EXPECT().Title().Return("v1").AnyTimes()
+	v1.EXPECT().Phases().Return(1).AnyTimes()
+	v1.EXPECT().Capacity().Return(50.0).AnyTimes()
+	v1.EXPECT().OnIdentified().Return(api.ActionConfig{}).AnyTimes()
+
+	v2.EXPECT().Title().Return("v2").AnyTimes()
+	v2.EXPECT().Phases().Return(3).AnyTimes()
+	v2.EXPECT().Capacity().Return(80.0).AnyTimes()
+	v2.EXPECT().OnIdentified().Return(api.ActionConfig{MaxCurrent: &maxCurrent}).AnyTimes()
+
+	var lp loadpoint.API
+	c := New(util.NewLogger("foo"), []api.Vehicle{v1, v2})
+	available := c.availableVehicles(lp)
+
+	// 3p charging excludes 1p vehicle, soc baseline not yet known
+	v2.EXPECT().Soc().Return(40.0, nil)
+
+	res, confidence := c.identifyVehicleBySignature(lp, available, Signature{Phases: 3, Current: 16, Offered: 16, Energy: 0})
+	assert.Nil(t, res)
+	assert.Less(t, confidence, SignatureThreshold)
+
+	// soc delta matches charged energy
+	v2.EXPECT().Soc().Return(49.0, nil)
+
+	res, confidence = c.identifyVehicleBySignature(lp, available, Signature{Phases: 3, Current: 16, Offered: 16, Energy: 8})
+	assert.Equal(t, v2, res)
+	assert.GreaterOrEqual(t, confidence, SignatureThreshold)
+
+	// new session discards the previous soc baseline
+	c.resetSignature(lp)
+	assert.Empty(t, c.baselines)
+
+	// vehicle exceeding its maximum current is excluded
+	assert.Equal(t, 0.0, c.signatureConfidence(lp, v2, Signature{Phases: 3, Current: 20, Offered: 32}))
+
+	// ambiguous signature
+	v1.EXPECT().Soc().Return(0.0, api.ErrNotAvailable)
+	v2.EXPECT().Soc().Return(0.0, api.ErrNotAvailable)
+
+	res, _ = c.identifyVehicleBySignature(lp, available, Signature{Phases: 1, Current: 10, Offered: 10, Energy: 3})
+	assert.Nil(t, res)
+}
@@ -22,3 +22,9 @@ func (a *dummy) Release(v api.Vehicle) {}
 func (a *dummy) IdentifyVehicleByStatus() api.Vehicle {
 	return nil
 }
+
+func (a *dummy) IdentifyVehicleBySignature(sig Signature) (api.Vehicle, float64) {
+	return nil, 0
+}
+
+func (a *dummy) ResetSignature() {}
@@ -0,0 +1,183 @@
+package coordinator
+
+import (
+	"math"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/quota"
+	"github.com/evcc-io/evcc/core/soc"
+)
+
+const (
+	// SignatureThreshold is the minimum confidence for selecting a vehicle by charging signature
+	SignatureThreshold = 0.75
+	// signatureMargin is the minimum confidence lead over the second best vehicle
+	signatureMargin = 0.2
+
+	currentTolerance = 1.0 // A
+	socMinEnergy     = 2.0 // kWh charged before soc deltas are compared
+	socMinDelta      = 5.0 // % soc tolerance for small deltas
+
+	weightPhases  = 1.0
+	weightCurrent = 1.0
+	weightSoc     = 2.0
+)
+
+// Signature is the observed charging behavior of an unidentified vehicle
+type Signature struct {
+	Phases  int     // measured phases, 0 if unknown
+	Current float64 // max measured phase current in A, 0 if unknown
+	Offered float64 // charge current offered by the charger in A
+	Energy  float64 // energy charged since connect in kWh
+}
+
+// baseline is the vehicle's soc when its signature was first compared
+type baseline struct {
+	owner  loadpoint.API
+	soc    float64
+	energy float64
+}
+
+// evidence accumulates weighted scores, unknown criteria count as neutral
+type evidence struct {
+	score, weight float64
+}
+
+func (e *evidence) add(score, weight float64) {
+	e.score += score * weight
+	e.weight += weight
+}
+
+func (e *evidence) confidence() float64 {
+	return e.score / e.weight
+}
+
+// signatureConfidence scores the vehicle's match with the observed charging signature between 0 and 1
+func (c *Coordinator) signatureConfidence(owner loadpoint.API, vehicle api.Vehicle, sig Signature) float64 {
+	var ev evidence
+
+	// phases
+	switch phases := vehicle.Phases(); {
+	case sig.Phases == 0 || phases == 0:
+		ev.add(0.5, weightPhases)
+	case sig.Phases > phases:
+		return 0 // vehicle can't draw more phases than it supports
+	case sig.Phases == phases:
+		ev.add(1, weightPhases)
+	default:
+		// charger may supply fewer phases than the vehicle supports
+		ev.add(0.5, weightPhases)
+	}
+
+	// current
+	var maxCurrent float64
+	if mc := vehicle.OnIdentified().MaxCurrent; mc != nil {
+		maxCurrent = *mc
+	}
+
+	switch {
+	case sig.Current == 0 || maxCurrent == 0:
+		ev.add(0.5, weightCurrent)
+	case sig.Current > maxCurrent+currentTolerance:
+		return 0 // vehicle draws more than its maximum current
+	case sig.Offered > sig.Current+currentTolerance:
+		// current limited by vehicle, compare with its maximum current
+		if math.Abs(sig.Current-maxCurrent) <= currentTolerance {
+			ev.add(1, weightCurrent)
+		} else {
+			ev.add(0, weightCurrent)
+		}
+	default:
+		// current limited by charger
+		ev.add(0.5, weightCurrent)
+	}
+
+	// soc delta vs. charged energy
+	ev.add(c.socScore(owner, vehicle, sig), weightSoc)
+
+	return ev.confidence()
+}
+
+// socScore compares the vehicle's soc delta with the delta expected from the charged energy
+func (c *Coordinator) socScore(owner loadpoint.API, vehicle api.Vehicle, sig Signature) float64 {
+	capacity := vehicle.Capacity()
+	if capacity <= 0 {
+		return 0.5
+	}
+
+	// respect vehicle api quota, identification must not use the charging budget
+	if quota.Instance != nil && !quota.Instance.Allow(vehicle, false) {
+		return c.lastSocScore(vehicle)
+	}
+
+	f, err := vehicle.Soc()
+	if quota.Instance != nil {
+		quota.Instance.Done(vehicle, err)
+	}
+	if err != nil {
+		return 0.5
+	}
+
+	b, ok := c.baselines[vehicle]
+	if !ok || b.owner != owner || sig.Energy < b.energy {
+		c.baselines[vehicle] = baseline{owner: owner, soc: f, energy: sig.Energy}
+		c.socScores[vehicle] = 0.5
+		return 0.5
+	}
+
+	energy := sig.Energy - b.energy
+	if energy < socMinEnergy {
+		return 0.5
+	}
+
+	expected := energy * soc.ChargeEfficiency / capacity * 100
+	observed := f - b.soc
+
+	score := math.Max(0, 1-math.Abs(observed-expected)/math.Max(expected, socMinDelta))
+	c.socScores[vehicle] = score
+
+	return score
+}
+
+// resetSignature discards the soc baselines of the owner's previous session
+func (c *Coordinator) resetSignature(owner loadpoint.API) {
+	for vehicle, b := range c.baselines {
+		if b.owner == owner {
+			delete(c.baselines, vehicle)
+			delete(c.socScores, vehicle)
+		}
+	}
+}
+
+// lastSocScore returns the previous soc score if the vehicle api can't be polled
+func (c *Coordinator) lastSocScore(vehicle api.Vehicle) float64 {
+	if score, ok := c.socScores[vehicle]; ok {
+		return score
+	}
+	return 0.5
+}
+
+// identifyVehicleBySignature finds the vehicle best matching the observed charging signature
+func (c *Coordinator) identifyVehicleBySignature(owner loadpoint.API, available []api.Vehicle, sig Signature) (api.Vehicle, float64) {
+	var res api.Vehicle
+	var best, second float64
+
+	for _, vehicle := range available {
+		confidence := c.signatureConfidence(owner, vehicle, sig)
+		c.log.DEBUG.Printf("vehicle signature: %.2f confidence (%s)", confidence, vehicle.Title())
+
+		switch {
+		case confidence > best:
+			res, best, second = vehicle, confidence, best
+		case confidence > second:
+			second = confidence
+		}
+	}
+
+	if best < SignatureThreshold || best-second < signatureMargin {
+		return nil, best
+	}
+
+	return res, best
+}
@@ -19,16 +19,19 @@ import (
 
 // Session is a single charging session
 type Session struct {
-	ID            uint      `json:"id" csv:"-" gorm:"primarykey"`
-	Created       time.Time `json:"created"`
-	Finished      time.Time `json:"finished"`
-	Loadpoint     string    `json:"loadpoint"`
-	Identifier    string    `json:"identifier"`
-	Vehicle       string    `json:"vehicle"`
-	Odometer      float64   `json:"odometer" format:"int"`
-	MeterStart    float64   `json:"meterStart" csv:"Meter Start (kWh)" gorm:"column:meter_start_kwh"`
-	MeterStop     float64   `json:"meterStop" csv:"Meter Stop (kWh)" gorm:"column:meter_end_kwh"`
-	ChargedEnergy float64   `json:"chargedEnergy" csv:"Charged Energy (kWh)" gorm:"column:charged_kwh"`
+	ID              uint      `json:"id" csv:"-" gorm:"primarykey"`
+	Created         time.Time `json:"created"`
+	Finished        time.Time `json:"finished"`
+	Loadpoint       string    `json:"loadpoint"`
+	Identifier      string    `json:"identifier"`
+	Vehicle         string    `json:"vehicle"`
+	Odometer        float64   `json:"odometer" format:"int"`
+	MeterStart      float64   `json:"meterStart" csv:"Meter Start (kWh)" gorm:"column:meter_start_kwh"`
+	MeterStop       float64   `json:"meterStop" csv:"Meter Stop (kWh)" gorm:"column:meter_end_kwh"`
+	ChargedEnergy   float64   `json:"chargedEnergy" csv:"Charged Energy (kWh)" gorm:"column:charged_kwh"`
+	SolarPercentage float64   `json:"solarPercentage" csv:"Solar (%)" format:"int"`
+	Price           float64   `json:"price" csv:"Price"`
+	PricePerKWh     float64   `json:"pricePerKWh" csv:"Price/kWh"`
 }
 
 // Sessions is a list of sessions
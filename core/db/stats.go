@@ -0,0 +1,103 @@
+package db
+
+import (
+	"sort"
+)
+
+// VehicleStats are trip and consumption statistics computed from consecutive sessions
+type VehicleStats struct {
+	Month         string  `json:"month,omitempty"` // yyyy-mm, empty for totals
+	Trips         int     `json:"trips"`           // number of trips between sessions with known odometer
+	Distance      float64 `json:"distance"`        // km driven
+	Energy        float64 `json:"energy"`          // kWh charged for distance driven
+	SolarEnergy   float64 `json:"solarEnergy"`     // solar kWh charged for distance driven
+	Cost          float64 `json:"cost"`            // cost of energy charged for distance driven
+	Consumption   float64 `json:"consumption"`     // kWh/100km
+	CostPer100km  float64 `json:"costPer100km"`    // cost/100km
+	SolarDistance float64 `json:"solarDistance"`   // km driven on solar energy
+	SolarShare    float64 `json:"solarShare"`      // solar energy per km in %
+}
+
+// add accounts a trip
+func (s *VehicleStats) add(distance, energy, solarEnergy, cost float64) {
+	s.Trips++
+	s.Distance += distance
+	s.Energy += energy
+	s.SolarEnergy += solarEnergy
+	s.Cost += cost
+}
+
+// finish calculates the per-distance values
+func (s *VehicleStats) finish() {
+	if s.Distance > 0 {
+		s.Consumption = s.Energy / s.Distance * 100
+		s.CostPer100km = s.Cost / s.Distance * 100
+	}
+
+	if s.Energy > 0 {
+		s.SolarShare = s.SolarEnergy / s.Energy * 100
+		s.SolarDistance = s.Distance * s.SolarEnergy / s.Energy
+	}
+}
+
+// VehicleSummary are the total and monthly statistics of a vehicle
+type VehicleSummary struct {
+	Vehicle string         `json:"vehicle"`
+	Total   VehicleStats   `json:"total"`
+	Months  []VehicleStats `json:"months"`
+}
+
+// Stats computes vehicle statistics from the vehicle's sessions.
+// The energy charged after a trip, i.e. until and including the next session with known odometer,
+// is attributed to the distance driven since the previous session with known odometer.
+// Trips are accounted to the month of the session ending the trip.
+func (t Sessions) Stats(vehicle string) VehicleSummary {
+	sessions := make(Sessions, 0, len(t))
+	for _, s := range t {
+		if s.Vehicle == vehicle {
+			sessions = append(sessions, s)
+		}
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Created.Before(sessions[j].Created)
+	})
+
+	res := VehicleSummary{
+		Vehicle: vehicle,
+		Months:  make([]VehicleStats, 0),
+	}
+
+	var odometer, energy, solarEnergy, cost float64
+
+	for _, s := range sessions {
+		energy += s.ChargedEnergy
+		solarEnergy += s.ChargedEnergy * s.SolarPercentage / 100
+		cost += s.Price
+
+		if s.Odometer <= 0 {
+			continue
+		}
+
+		// skip first known odometer and odometer resets
+		if distance := s.Odometer - odometer; odometer > 0 && distance > 0 {
+			month := s.Created.Local().Format("2006-01")
+			if n := len(res.Months); n == 0 || res.Months[n-1].Month != month {
+				res.Months = append(res.Months, VehicleStats{Month: month})
+			}
+
+			res.Months[len(res.Months)-1].add(distance, energy, solarEnergy, cost)
+			res.Total.add(distance, energy, solarEnergy, cost)
+		}
+
+		odometer = s.Odometer
+		energy, solarEnergy, cost = 0, 0, 0
+	}
+
+	for i := range res.Months {
+		res.Months[i].finish()
+	}
+	res.Total.finish()
+
+	return res
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2023, month, d, 12, 0, 0, 0, time.Local)
+	}
+
+	sessions := Sessions{
+		{Vehicle: "car", Created: day(1, 1), Odometer: 1000, ChargedEnergy: 30},
+		{Vehicle: "other", Created: day(1, 2), Odometer: 5000, ChargedEnergy: 50},
+		{Vehicle: "car", Created: day(1, 10), Odometer: 1100, ChargedEnergy: 20, SolarPercentage: 50, Price: 4},
+		// unknown odometer, energy attributed to next trip
+		{Vehicle: "car", Created: day(2, 1), ChargedEnergy: 10, SolarPercentage: 100, Price: 1},
+		{Vehicle: "car", Created: day(2, 5), Odometer: 1300, ChargedEnergy: 30, Price: 9},
+	}
+
+	res := sessions.Stats("car")
+
+	assert.Equal(t, "car", res.Vehicle)
+	assert.Equal(t, 2, res.Total.Trips)
+	assert.Equal(t, 300.0, res.Total.Distance)
+	assert.Equal(t, 60.0, res.Total.Energy)
+	assert.Equal(t, 20.0, res.Total.SolarEnergy)
+	assert.Equal(t, 14.0, res.Total.Cost)
+	assert.Equal(t, 20.0, res.Total.Consumption)
+	assert.InDelta(t, 14.0/3, res.Total.CostPer100km, 1e-9)
+	assert.Equal(t, 100.0, res.Total.SolarDistance)
+	assert.InDelta(t, 100.0/3, res.Total.SolarShare, 1e-9)
+
+	assert.Len(t, res.Months, 2)
+	assert.Equal(t, "2023-01", res.Months[0].Month)
+	assert.Equal(t, 100.0, res.Months[0].Distance)
+	assert.Equal(t, 20.0, res.Months[0].Consumption)
+	assert.Equal(t, 50.0, res.Months[0].SolarShare)
+	assert.Equal(t, "2023-02", res.Months[1].Month)
+	assert.Equal(t, 200.0, res.Months[1].Distance)
+	assert.Equal(t, 5.0, res.Months[1].CostPer100km)
+}
@@ -46,3 +46,16 @@ func vehicleTitles(vehicles []api.Vehicle) []string {
 		return v.Title()
 	})
 }
+
+// liveDevice returns the live device once a wrapped device that failed to
+// initialize has become available
+func liveDevice[T any](dev T) (T, bool) {
+	if w, ok := any(dev).(interface{ Live() T }); ok {
+		if res := w.Live(); any(res) != nil {
+			return res, true
+		}
+	}
+
+	var res T
+	return res, false
+}
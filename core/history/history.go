@@ -0,0 +1,269 @@
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/util"
+	"gorm.io/gorm"
+)
+
+const (
+	Minute = time.Minute
+	Hour   = time.Hour
+
+	// MinuteRetention is the duration minute-resolution data is kept for
+	MinuteRetention = 7 * 24 * time.Hour
+)
+
+// Instance is the history store instance if configured
+var Instance *History
+
+// Entry is a single history slot. Powers are averages in W, energies are in kWh.
+type Entry struct {
+	Resolution       int       `json:"-" gorm:"primarykey;autoIncrement:false"` // slot length in seconds
+	Timestamp        time.Time `json:"timestamp" gorm:"primarykey"`             // slot start
+	GridPower        float64   `json:"gridPower"`
+	PvPower          float64   `json:"pvPower"`
+	BatteryPower     float64   `json:"batteryPower"`
+	HomePower        float64   `json:"homePower"`
+	ChargePower      float64   `json:"chargePower"`
+	GridImport       float64   `json:"gridImport"`
+	GridExport       float64   `json:"gridExport"`
+	Pv               float64   `json:"pv"`
+	BatteryCharge    float64   `json:"batteryCharge"`
+	BatteryDischarge float64   `json:"batteryDischarge"`
+	Home             float64   `json:"home"`
+	Charge           float64   `json:"charge"`
+	Covered          int64     `json:"-"` // seconds actually recorded within the slot
+}
+
+// TableName implements gorm's Tabler interface
+func (Entry) TableName() string {
+	return "history"
+}
+
+// add accumulates energies of another entry
+func (e *Entry) add(o Entry) {
+	e.GridImport += o.GridImport
+	e.GridExport += o.GridExport
+	e.Pv += o.Pv
+	e.BatteryCharge += o.BatteryCharge
+	e.BatteryDischarge += o.BatteryDischarge
+	e.Home += o.Home
+	e.Charge += o.Charge
+}
+
+// duration returns the time actually recorded within the slot
+func (e *Entry) duration() time.Duration {
+	return time.Duration(e.Covered) * time.Second
+}
+
+// average calculates average powers from energies for the given duration
+func (e *Entry) average(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	h := d.Hours() / 1e3 // kWh to W
+	e.GridPower = (e.GridImport - e.GridExport) / h
+	e.PvPower = e.Pv / h
+	e.BatteryPower = (e.BatteryDischarge - e.BatteryCharge) / h
+	e.HomePower = e.Home / h
+	e.ChargePower = e.Charge / h
+}
+
+// slot accumulates energies for a time slot of given resolution
+type slot struct {
+	Entry
+	covered time.Duration // time actually recorded within the slot
+}
+
+// load merges a slot persisted by a previous run, e.g. before restart
+func (h *History) load(s *slot) {
+	var e Entry
+	if err := h.db.Where("resolution = ? AND timestamp = ?", s.Resolution, s.Timestamp).Limit(1).Find(&e).Error; err != nil {
+		h.log.ERROR.Printf("load: %v", err)
+		return
+	}
+
+	if e.Resolution != 0 {
+		s.Entry = e
+		s.covered = e.duration()
+	}
+}
+
+// History is a downsampling time series store
+type History struct {
+	mu    sync.Mutex
+	log   *util.Logger
+	clock clock.Clock
+	db    *gorm.DB
+
+	updated time.Time       // time of last integration
+	grid    float64         // current grid power
+	pv      float64         // current pv power
+	battery float64         // current battery power
+	home    float64         // current home power
+	charge  map[int]float64 // current charge power per loadpoint
+
+	slots map[time.Duration]*slot
+}
+
+// New creates a history store
+func New(db *gorm.DB) (*History, error) {
+	h := &History{
+		log:    util.NewLogger("history"),
+		clock:  clock.New(),
+		db:     db,
+		charge: make(map[int]float64),
+		slots:  make(map[time.Duration]*slot),
+	}
+
+	return h, db.AutoMigrate(new(Entry))
+}
+
+// chargePower returns the total charge power of all loadpoints
+func (h *History) chargePower() float64 {
+	var res float64
+	for _, p := range h.charge {
+		res += p
+	}
+	return res
+}
+
+// integrate accumulates energy from the current powers until ts, flushing completed slots
+func (h *History) integrate(ts time.Time) {
+	if h.updated.IsZero() {
+		h.updated = ts
+		return
+	}
+
+	for h.updated.Before(ts) {
+		// split at minute boundaries
+		end := h.updated.Truncate(Minute).Add(Minute)
+		if end.After(ts) {
+			end = ts
+		}
+
+		d := end.Sub(h.updated)
+		kwh := func(power float64) float64 {
+			return power * d.Hours() / 1e3
+		}
+
+		for _, res := range []time.Duration{Minute, Hour} {
+			s := h.slot(res, h.updated)
+			s.covered += d
+
+			if h.grid > 0 {
+				s.GridImport += kwh(h.grid)
+			} else {
+				s.GridExport += kwh(-h.grid)
+			}
+			if h.battery > 0 {
+				s.BatteryDischarge += kwh(h.battery)
+			} else {
+				s.BatteryCharge += kwh(-h.battery)
+			}
+			s.Pv += kwh(h.pv)
+			s.Home += kwh(h.home)
+			s.Charge += kwh(h.chargePower())
+		}
+
+		h.updated = end
+		h.flush(end, false)
+	}
+}
+
+// slot returns the accumulating slot of given resolution for ts
+func (h *History) slot(res time.Duration, ts time.Time) *slot {
+	start := ts.Truncate(res)
+
+	s, ok := h.slots[res]
+	if !ok || !s.Timestamp.Equal(start) {
+		s = &slot{Entry: Entry{
+			Resolution: int(res.Seconds()),
+			Timestamp:  start,
+		}}
+		h.load(s)
+		h.slots[res] = s
+	}
+
+	return s
+}
+
+// flush persists all slots that have ended at ts or all slots if forced
+func (h *History) flush(ts time.Time, force bool) {
+	for res, s := range h.slots {
+		if !force && ts.Before(s.Timestamp.Add(res)) {
+			continue
+		}
+
+		s.average(s.covered)
+		s.Covered = int64(s.covered / time.Second)
+		if err := h.db.Save(&s.Entry).Error; err != nil {
+			h.log.ERROR.Printf("persist: %v", err)
+		}
+
+		if !force {
+			delete(h.slots, res)
+		}
+
+		if res == Hour && !force {
+			h.purge(ts)
+		}
+	}
+}
+
+// purge removes minute records older than the retention period
+func (h *History) purge(ts time.Time) {
+	if err := h.db.Where("resolution = ? AND timestamp < ?", int(Minute.Seconds()), ts.Add(-MinuteRetention)).Delete(new(Entry)).Error; err != nil {
+		h.log.ERROR.Printf("purge: %v", err)
+	}
+}
+
+// update processes a single published value
+func (h *History) update(p util.Param) {
+	val, ok := p.Val.(float64)
+	if !ok {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch {
+	case p.Loadpoint != nil && p.Key == "chargePower":
+		h.integrate(h.clock.Now())
+		h.charge[*p.Loadpoint] = val
+	case p.Loadpoint == nil && p.Key == "gridPower":
+		h.integrate(h.clock.Now())
+		h.grid = val
+	case p.Loadpoint == nil && p.Key == "pvPower":
+		h.integrate(h.clock.Now())
+		h.pv = val
+	case p.Loadpoint == nil && p.Key == "batteryPower":
+		h.integrate(h.clock.Now())
+		h.battery = val
+	case p.Loadpoint == nil && p.Key == "homePower":
+		h.integrate(h.clock.Now())
+		h.home = val
+	}
+}
+
+// Run records the relevant values received from the input channel
+func (h *History) Run(in <-chan util.Param) {
+	for p := range in {
+		h.update(p)
+	}
+}
+
+// Persist writes the current incomplete slots to the database
+func (h *History) Persist() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.integrate(h.clock.Now())
+	h.flush(h.clock.Now(), true)
+}
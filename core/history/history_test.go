@@ -0,0 +1,150 @@
+package history
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newHistory(t *testing.T) (*History, *clock.Mock) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), new(gorm.Config))
+	require.NoError(t, err)
+
+	h, err := New(db)
+	require.NoError(t, err)
+
+	clck := clock.NewMock()
+	clck.Set(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC))
+	h.clock = clck
+
+	return h, clck
+}
+
+func TestHistoryIntegration(t *testing.T) {
+	h, clck := newHistory(t)
+	lp := 0
+
+	h.update(util.Param{Key: "gridPower", Val: -1000.0})
+	h.update(util.Param{Key: "pvPower", Val: 3000.0})
+	h.update(util.Param{Key: "homePower", Val: 500.0})
+	h.update(util.Param{Key: "chargePower", Val: 1500.0, Loadpoint: &lp})
+
+	// 2 hours of constant power
+	for i := 0; i < 2*60*6; i++ {
+		clck.Add(10 * time.Second)
+		h.update(util.Param{Key: "gridPower", Val: -1000.0})
+	}
+
+	minutes, err := h.entries(Minute, clck.Now().Add(-3*time.Hour), clck.Now())
+	require.NoError(t, err)
+	assert.Len(t, minutes, 120)
+	assert.InDelta(t, 1.0/60, minutes[0].GridExport, 1e-9)
+	assert.InDelta(t, -1000, minutes[0].GridPower, 1e-6)
+
+	hours, err := h.Query(clck.Now().Add(-3*time.Hour), clck.Now(), Hour)
+	require.NoError(t, err)
+	require.Len(t, hours, 2)
+	assert.InDelta(t, 3, hours[0].Pv, 1e-9)
+	assert.InDelta(t, 1.5, hours[0].Charge, 1e-9)
+	assert.InDelta(t, 1500, hours[1].ChargePower, 1e-6)
+
+	quarters, err := h.Query(clck.Now().Add(-2*time.Hour), clck.Now(), 15*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, quarters, 8)
+	assert.InDelta(t, 0.125, quarters[0].Home, 1e-9)
+	assert.InDelta(t, 3000, quarters[0].PvPower, 1e-6)
+}
+
+func TestHistoryTotals(t *testing.T) {
+	h, clck := newHistory(t)
+
+	h.update(util.Param{Key: "gridPower", Val: 1000.0})
+	h.update(util.Param{Key: "pvPower", Val: 2000.0})
+	h.update(util.Param{Key: "homePower", Val: 3000.0})
+
+	clck.Add(time.Hour)
+	h.update(util.Param{Key: "gridPower", Val: -1000.0})
+	h.update(util.Param{Key: "homePower", Val: 1000.0})
+
+	clck.Add(time.Hour)
+	h.Persist()
+
+	stats, err := h.Totals(clck.Now().Add(-24*time.Hour), clck.Now(), Day)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+
+	s := stats[0]
+	assert.InDelta(t, 1, s.GridImport, 1e-9)
+	assert.InDelta(t, 1, s.GridExport, 1e-9)
+	assert.InDelta(t, 4, s.Pv, 1e-9)
+	assert.InDelta(t, 0.75, s.SelfConsumption, 1e-9)
+	assert.InDelta(t, 0.75, s.Autarky, 1e-9)
+
+	_, err = h.Totals(clck.Now().Add(-24*time.Hour), clck.Now(), "week")
+	assert.Error(t, err)
+}
+
+func TestHistoryQueryPartial(t *testing.T) {
+	h, clck := newHistory(t)
+
+	h.update(util.Param{Key: "pvPower", Val: 3000.0})
+
+	// 10 minutes of a 15 minute bucket recorded
+	for i := 0; i < 10*6; i++ {
+		clck.Add(10 * time.Second)
+		h.update(util.Param{Key: "pvPower", Val: 3000.0})
+	}
+	h.Persist()
+
+	res, err := h.Query(clck.Now().Add(-time.Hour), clck.Now().Add(time.Hour), 15*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.InDelta(t, 0.5, res[0].Pv, 1e-9)
+	assert.InDelta(t, 3000, res[0].PvPower, 1e-6)
+}
+
+func TestHistoryQueryBeyondRetention(t *testing.T) {
+	h, clck := newHistory(t)
+
+	h.update(util.Param{Key: "pvPower", Val: 2000.0})
+	clck.Add(2 * time.Hour)
+	h.Persist()
+
+	// minute data has expired, hourly data is returned
+	clck.Add(MinuteRetention)
+	res, err := h.Query(clck.Now().Add(-MinuteRetention-3*time.Hour), clck.Now(), 15*time.Minute)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int(Hour.Seconds()), res[0].Resolution)
+	assert.InDelta(t, 2000, res[0].PvPower, 1e-6)
+}
+
+func TestHistoryRestart(t *testing.T) {
+	h, clck := newHistory(t)
+
+	h.update(util.Param{Key: "pvPower", Val: 2000.0})
+	clck.Add(30 * time.Minute)
+	h.Persist()
+
+	// new instance continues the persisted slot
+	h2, err := New(h.db)
+	require.NoError(t, err)
+	h2.clock = clck
+
+	h2.update(util.Param{Key: "pvPower", Val: 2000.0})
+	clck.Add(30 * time.Minute)
+	h2.update(util.Param{Key: "pvPower", Val: 2000.0})
+
+	hours, err := h2.entries(Hour, clck.Now().Add(-2*time.Hour), clck.Now())
+	require.NoError(t, err)
+	require.Len(t, hours, 1)
+	assert.InDelta(t, 2, hours[0].Pv, 1e-9)
+	assert.InDelta(t, 2000, hours[0].PvPower, 1e-6)
+}
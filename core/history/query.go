@@ -0,0 +1,173 @@
+package history
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Period is the aggregation period for totals
+type Period string
+
+const (
+	Day   Period = "day"
+	Month Period = "month"
+)
+
+// Stats are the aggregated energy totals of a period in kWh
+type Stats struct {
+	Timestamp        time.Time `json:"timestamp"`
+	GridImport       float64   `json:"gridImport"`
+	GridExport       float64   `json:"gridExport"`
+	Pv               float64   `json:"pv"`
+	BatteryCharge    float64   `json:"batteryCharge"`
+	BatteryDischarge float64   `json:"batteryDischarge"`
+	Home             float64   `json:"home"`
+	Charge           float64   `json:"charge"`
+	SelfConsumption  float64   `json:"selfConsumption"` // share of pv energy consumed on site
+	Autarky          float64   `json:"autarky"`         // share of consumption not imported from grid
+}
+
+// bucket returns the start of the aggregation bucket for ts
+func bucket(ts time.Time, res time.Duration) time.Time {
+	if res >= 24*time.Hour {
+		ts = ts.Local()
+		day := time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, time.Local)
+		days := int(res / (24 * time.Hour))
+		return day.AddDate(0, 0, -day.YearDay()%days)
+	}
+	return ts.Truncate(res)
+}
+
+// entries returns the stored entries of given resolution in the time range
+func (h *History) entries(res time.Duration, from, to time.Time) ([]Entry, error) {
+	var entries []Entry
+	err := h.db.Where("resolution = ? AND timestamp >= ? AND timestamp < ?", int(res.Seconds()), from, to).Order("timestamp").Find(&entries).Error
+	return entries, err
+}
+
+// Query returns the history for the time range aggregated to the given resolution
+func (h *History) Query(from, to time.Time, res time.Duration) ([]Entry, error) {
+	if res < Minute || !to.After(from) {
+		return nil, errors.New("invalid time range or resolution")
+	}
+
+	// minute data is only available for the retention period
+	source := Hour
+	if res < Hour && h.clock.Since(from) <= MinuteRetention {
+		source = Minute
+	}
+
+	entries, err := h.entries(source, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// resolution cannot be finer than the source data
+	if res < source {
+		res = source
+	}
+
+	res = res.Truncate(source)
+	if res == source {
+		return entries, nil
+	}
+
+	var (
+		result  []Entry
+		current *Entry
+	)
+
+	for _, e := range entries {
+		ts := bucket(e.Timestamp, res)
+
+		if current == nil || !current.Timestamp.Equal(ts) {
+			if current != nil {
+				current.average(current.duration())
+				result = append(result, *current)
+			}
+
+			current = &Entry{
+				Resolution: int(res.Seconds()),
+				Timestamp:  ts,
+			}
+		}
+
+		current.add(e)
+		current.Covered += e.Covered
+	}
+
+	if current != nil {
+		current.average(current.duration())
+		result = append(result, *current)
+	}
+
+	return result, nil
+}
+
+// Totals returns the energy totals for the time range aggregated by period
+func (h *History) Totals(from, to time.Time, period Period) ([]Stats, error) {
+	var key func(time.Time) time.Time
+
+	switch period {
+	case Day:
+		key = func(ts time.Time) time.Time {
+			return bucket(ts, 24*time.Hour)
+		}
+	case Month:
+		key = func(ts time.Time) time.Time {
+			ts = ts.Local()
+			return time.Date(ts.Year(), ts.Month(), 1, 0, 0, 0, 0, time.Local)
+		}
+	default:
+		return nil, errors.New("invalid period")
+	}
+
+	entries, err := h.entries(Hour, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result  []Stats
+		current *Stats
+	)
+
+	for _, e := range entries {
+		ts := key(e.Timestamp)
+
+		if current == nil || !current.Timestamp.Equal(ts) {
+			if current != nil {
+				result = append(result, current.complete())
+			}
+			current = &Stats{Timestamp: ts}
+		}
+
+		current.GridImport += e.GridImport
+		current.GridExport += e.GridExport
+		current.Pv += e.Pv
+		current.BatteryCharge += e.BatteryCharge
+		current.BatteryDischarge += e.BatteryDischarge
+		current.Home += e.Home
+		current.Charge += e.Charge
+	}
+
+	if current != nil {
+		result = append(result, current.complete())
+	}
+
+	return result, nil
+}
+
+// complete calculates the derived shares
+func (s *Stats) complete() Stats {
+	if s.Pv > 0 {
+		s.SelfConsumption = math.Max(0, 1-s.GridExport/s.Pv)
+	}
+
+	if consumption := s.Home + s.Charge; consumption > 0 {
+		s.Autarky = math.Max(0, 1-s.GridImport/consumption)
+	}
+
+	return *s
+}
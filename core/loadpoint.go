@@ -27,14 +27,19 @@ import (
 )
 
 const (
-	evChargeStart         = "start"      // update chargeTimer
-	evChargeStop          = "stop"       // update chargeTimer
-	evChargeCurrent       = "current"    // update fakeChargeMeter
-	evChargePower         = "power"      // update chargeRater
-	evVehicleConnect      = "connect"    // vehicle connected
-	evVehicleDisconnect   = "disconnect" // vehicle disconnected
-	evVehicleSoc          = "soc"        // vehicle soc progress
-	evVehicleUnidentified = "guest"      // vehicle unidentified
+	evChargeStart         = "start"        // update chargeTimer
+	evChargeStop          = "stop"         // update chargeTimer
+	evChargeCurrent       = "current"      // update fakeChargeMeter
+	evChargePower         = "power"        // update chargeRater
+	evVehicleConnect      = "connect"      // vehicle connected
+	evVehicleDisconnect   = "disconnect"   // vehicle disconnected
+	evVehicleSoc          = "soc"          // vehicle soc progress
+	evVehicleUnidentified = "guest"        // vehicle unidentified
+	evVehicleIdentified   = "identified"   // vehicle identified
+	evPlanStart           = "planstart"    // charging plan started
+	evTargetReached       = "reached"      // target soc or energy reached
+	evPrecondition        = "precondition" // climate preconditioning started
+	evError               = "error"        // charger or control error
 
 	pvTimer   = "pv"
 	pvEnable  = "enable"
@@ -53,6 +58,7 @@ const (
 	minActiveVoltage = 208 // minimum voltage at which a phase is treated as active
 
 	guardGracePeriod = 60 * time.Second // allow out of sync during this timespan
+	errorClearPeriod = 5 * time.Minute  // error must be cleared for this timespan before being sent again
 )
 
 // elapsed is the time an expired timer will be set to
@@ -122,6 +128,8 @@ type Loadpoint struct {
 	MinCurrent    float64       // PV mode: start current	Min+PV mode: min current
 	MaxCurrent    float64       // Max allowed current. Physically ensured by the charger
 	GuardDuration time.Duration // charger enable/disable minimum holding time
+	Precondition  time.Duration // climate preconditioning duration before target time
+	Control       ControlConfig // pv surplus control strategy
 
 	enabled             bool      // Charger enabled state
 	phases              int       // Charger enabled phases, guarded by mutex
@@ -131,7 +139,9 @@ type Loadpoint struct {
 	socUpdated          time.Time // Soc updated timestamp (poll: connected)
 	vehicleDetect       time.Time // Vehicle connected timestamp
 	vehicleDetectTicker *clock.Ticker
+	signatureUpdated    time.Time // last vehicle identification by charging signature
 	vehicleIdentifier   string
+	chargerVehicle      bool // implicit vehicle from charger data created for current connection
 
 	charger          api.Charger
 	chargeTimer      api.ChargeTimer
@@ -150,6 +160,15 @@ type Loadpoint struct {
 	planSlotEnd time.Time // current plan slot end time
 	planActive  bool      // plan is active
 
+	// departure preconditioning
+	preconditionTime  time.Time // target time preconditioning has been started for
+	preconditionRetry time.Time // preconditioning not retried before after error
+
+	// push state
+	targetReached bool      // target reached event sent
+	lastError     string    // last error event sent
+	errorCleared  time.Time // last error has been cleared since
+
 	// cached state
 	status         api.ChargeStatus       // Charger status
 	remoteDemand   loadpoint.RemoteDemand // External status demand
@@ -162,6 +181,10 @@ type Loadpoint struct {
 
 	// charge progress
 	vehicleSoc              float64       // Vehicle Soc
+	vehicleSocLimit         int           // Vehicle soc limit set by loadpoint
+	vehicleSocLimitLast     int           // Vehicle soc limit last reported by vehicle
+	vehicleSocLimitRetry    time.Time     // Vehicle soc limit not retried before after error
+	vehicleSocRequest       *float64      // Vehicle soc set by user, applied on next update
 	chargeDuration          time.Duration // Charge duration
 	chargedEnergy           float64       // Charged energy while connected in Wh
 	chargeRemainingDuration time.Duration // Remaining charge duration
@@ -169,8 +192,18 @@ type Loadpoint struct {
 	progress                *Progress     // Step-wise progress indicator
 
 	// session log
-	db      db.Database
-	session *db.Session
+	db                 db.Database
+	session            *db.Session
+	sessionEnergy      float64 // session energy accounted for solar share and cost in kWh
+	sessionSolarEnergy float64 // session solar energy in kWh
+	sessionCost        float64 // session energy cost
+
+	pi *piController // pv surplus pi controller
+
+	// decision trace
+	decision loadpoint.Decision   // current update cycle
+	traceMu  sync.Mutex           // guard trace
+	trace    []loadpoint.Decision // recent update cycles
 
 	tasks *util.Queue[Task] // tasks to be executed
 }
@@ -255,6 +288,10 @@ func NewLoadpointFromConfig(log *util.Logger, cp configProvider, other map[strin
 		lp.log.WARN.Printf("locking phase config to %dp for switchable charger", lp.ConfiguredPhases)
 	}
 
+	if err := lp.configureControl(); err != nil {
+		return nil, err
+	}
+
 	// validate thresholds
 	if lp.Enable.Threshold > lp.Disable.Threshold {
 		lp.log.WARN.Printf("PV mode enable threshold (%.0fW) is larger than disable threshold (%.0fW)", lp.Enable.Threshold, lp.Disable.Threshold)
@@ -311,6 +348,7 @@ func (lp *Loadpoint) collectDefaults() {
 		*actionCfg.MinSoc = lp.GetMinSoc()
 		*actionCfg.TargetSoc = lp.GetTargetSoc()
 		*actionCfg.Priority = lp.Priority()
+		*actionCfg.Precondition = lp.Precondition
 	} else {
 		lp.log.ERROR.Printf("error allocating action config: %v", err)
 	}
@@ -378,7 +416,43 @@ func (lp *Loadpoint) configureChargerType(charger api.Charger) {
 
 // pushEvent sends push messages to clients
 func (lp *Loadpoint) pushEvent(event string) {
-	lp.pushChan <- push.Event{Event: event}
+	// test guard
+	if lp.pushChan != nil {
+		lp.pushChan <- push.Event{Event: event}
+	}
+}
+
+// pushError sends the error push message once per distinct error.
+// A recurring error is only sent again after it has been cleared for errorClearPeriod.
+func (lp *Loadpoint) pushError(err error) {
+	if err == nil {
+		if lp.lastError != "" && lp.errorCleared.IsZero() {
+			lp.errorCleared = lp.clock.Now()
+		}
+
+		if !lp.errorCleared.IsZero() && lp.clock.Since(lp.errorCleared) >= errorClearPeriod {
+			lp.lastError, lp.errorCleared = "", time.Time{}
+		}
+
+		return
+	}
+
+	lp.errorCleared = time.Time{}
+
+	if msg := err.Error(); msg != lp.lastError {
+		lp.publish("lastError", msg)
+		lp.pushEvent(evError)
+		lp.lastError = msg
+	}
+}
+
+// pushTargetReached sends the target reached push message once per goal
+func (lp *Loadpoint) pushTargetReached(reached bool) {
+	if reached && !lp.targetReached {
+		lp.pushEvent(evTargetReached)
+	}
+
+	lp.targetReached = reached
 }
 
 // publish sends values to UI and databases
@@ -442,9 +516,13 @@ func (lp *Loadpoint) evVehicleConnectHandler() {
 	// soc update reset
 	lp.socUpdated = time.Time{}
 
+	// allow implicit vehicle from charger data
+	lp.chargerVehicle = false
+
 	// soc update reset on car change
 	if lp.socEstimator != nil {
 		lp.socEstimator.Reset()
+		lp.restoreVehicleSoc()
 	}
 
 	// set default or start detection
@@ -476,6 +554,18 @@ func (lp *Loadpoint) evVehicleDisconnectHandler() {
 	// forget startup energy offset
 	lp.chargedAtStartup = 0
 
+	// remember soc of vehicle without soc api
+	lp.persistVehicleSoc()
+
+	// vehicle soc limit must be set again on next connect
+	lp.vehicleSocLimit = 0
+	lp.vehicleSocLimitLast = 0
+	lp.vehicleSocLimitRetry = time.Time{}
+
+	// stop tracking preconditioning
+	lp.preconditionTime = time.Time{}
+	lp.preconditionRetry = time.Time{}
+
 	// remove charger vehicle id and stop potential detection
 	lp.setVehicleIdentifier("")
 	lp.stopVehicleDetection()
@@ -529,8 +619,11 @@ func (lp *Loadpoint) evChargeCurrentWrappedMeterHandler(current float64) {
 		power = 0
 	}
 
-	// handler only called if charge meter was replaced by dummy
-	lp.chargeMeter.(*wrapper.ChargeMeter).SetPower(power)
+	// handler only called if charge meter was replaced by dummy,
+	// unless replaced by the meter of a charger that has become available
+	if mt, ok := lp.chargeMeter.(*wrapper.ChargeMeter); ok {
+		mt.SetPower(power)
+	}
 }
 
 // applyAction executes the action
@@ -550,6 +643,9 @@ func (lp *Loadpoint) applyAction(actionCfg api.ActionConfig) {
 	if actionCfg.TargetSoc != nil {
 		lp.SetTargetSoc(*actionCfg.TargetSoc)
 	}
+	if actionCfg.Precondition != nil {
+		lp.Precondition = *actionCfg.Precondition
+	}
 }
 
 // Prepare loadpoint configuration by adding missing helper elements
@@ -1088,7 +1184,16 @@ func (lp *Loadpoint) pvMaxCurrent(mode api.ChargeMode, sitePower float64, batter
 	deltaCurrent := powerToCurrent(-sitePower, activePhases)
 	targetCurrent := math.Max(effectiveCurrent+deltaCurrent, 0)
 
-	lp.log.DEBUG.Printf("pv charge current: %.3gA = %.3gA + %.3gA (%.0fW @ %dp)", targetCurrent, effectiveCurrent, deltaCurrent, sitePower, activePhases)
+	if lp.pi != nil && lp.enabled && lp.charging() {
+		deviation := powerToCurrent(lp.Control.Offset-sitePower, activePhases)
+		targetCurrent = lp.pi.update(lp.clock.Now(), deviation, effectiveCurrent, minCurrent, maxCurrent)
+		lp.log.DEBUG.Printf("pv charge current: %.3gA (pi: %.3gA deviation, %.0fW @ %dp)", targetCurrent, deviation, sitePower, activePhases)
+	} else {
+		if lp.pi != nil {
+			lp.pi.reset(effectiveCurrent)
+		}
+		lp.log.DEBUG.Printf("pv charge current: %.3gA = %.3gA + %.3gA (%.0fW @ %dp)", targetCurrent, effectiveCurrent, deltaCurrent, sitePower, activePhases)
+	}
 
 	// in MinPV mode or under special conditions return at least minCurrent
 	if (mode == api.ModeMinPV || batteryBuffered && lp.charging()) && targetCurrent < minCurrent {
@@ -1300,13 +1405,13 @@ func (lp *Loadpoint) publishChargeProgress() {
 
 // publish state of charge, remaining charge duration and range
 func (lp *Loadpoint) publishSocAndRange() {
-	soc, err := lp.chargerSoc()
+	soc, chargerErr := lp.chargerSoc()
 
 	// guard for socEstimator removed by api
 	if lp.socEstimator == nil {
 		// This is a workaround for heaters. Without vehicle, the soc estimator is not initialized.
 		// We need to check if the charger can provide soc and use it if available.
-		if err == nil {
+		if chargerErr == nil {
 			lp.vehicleSoc = soc
 			lp.publish(vehicleSoc, lp.vehicleSoc)
 		}
@@ -1314,10 +1419,25 @@ func (lp *Loadpoint) publishSocAndRange() {
 		return
 	}
 
-	if err == nil || lp.vehicleSocPollAllowed() {
+	if chargerErr == nil || lp.vehicleSocPollAllowed() {
 		lp.socUpdated = lp.clock.Now()
 
+		// respect vehicle api quota
+		allowed := lp.vehicleQuotaAllowed()
+		lp.socEstimator.Throttle(!allowed)
+
+		// merge charger soc with vehicle api soc
+		_, implicit := lp.vehicle.(*wrapper.ChargerVehicle)
+		poll := !implicit && allowed && lp.vehicleSocPollAllowed()
+		lp.socEstimator.PollVehicle(poll)
+
 		f, err := lp.socEstimator.Soc(lp.getChargedEnergy())
+
+		// vehicle api has been queried
+		if allowed && (chargerErr != nil || poll) && !lp.socEstimator.Estimated() {
+			lp.vehicleQuotaDone(err)
+		}
+
 		if err != nil {
 			if errors.Is(err, api.ErrMustRetry) {
 				lp.socUpdated = time.Time{}
@@ -1331,6 +1451,12 @@ func (lp *Loadpoint) publishSocAndRange() {
 		lp.vehicleSoc = f
 		lp.log.DEBUG.Printf("vehicle soc: %.0f%%", lp.vehicleSoc)
 		lp.publish(vehicleSoc, lp.vehicleSoc)
+		lp.publish(vehicleSocEstimated, lp.socEstimator.Estimated())
+
+		// learn charge curve while charging at maximum current
+		if lp.charging() && lp.chargeCurrent >= lp.GetMaxCurrent() {
+			lp.socEstimator.Sample(lp.chargePower, lp.activePhases())
+		}
 
 		// vehicle target soc
 		targetSoc := 100
@@ -1339,7 +1465,9 @@ func (lp *Loadpoint) publishSocAndRange() {
 				targetSoc = int(math.Trunc(limit))
 				lp.log.DEBUG.Printf("vehicle soc limit: %.0f%%", limit)
 				lp.publish(vehicleTargetSoc, limit)
-			} else {
+
+				lp.vehicleSocLimitReported(targetSoc)
+			} else if !errors.Is(err, api.ErrNotAvailable) {
 				lp.log.ERROR.Printf("vehicle soc limit: %v", err)
 			}
 		}
@@ -1363,7 +1491,7 @@ func (lp *Loadpoint) publishSocAndRange() {
 			if rng, err := vs.Range(); err == nil {
 				lp.log.DEBUG.Printf("vehicle range: %dkm", rng)
 				lp.publish(vehicleRange, rng)
-			} else {
+			} else if !errors.Is(err, api.ErrNotAvailable) {
 				lp.log.ERROR.Printf("vehicle range: %v", err)
 			}
 		}
@@ -1424,10 +1552,13 @@ func (lp *Loadpoint) guardGracePeriodElapsed() bool {
 // Update is the main control function. It reevaluates meters and charger state
 func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool) {
 	lp.processTasks()
+	lp.applyVehicleSoc()
 
 	mode := lp.GetMode()
 	lp.publish("mode", mode)
 
+	lp.startDecision(mode, sitePower, autoCharge, batteryBuffered)
+
 	// read and publish meters first- charge power has already been updated by the site
 	lp.updateChargeVoltages()
 	lp.updateChargeCurrents()
@@ -1442,6 +1573,8 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 	// read and publish status
 	if err := lp.updateChargerStatus(); err != nil {
 		lp.log.ERROR.Printf("charger: %v", err)
+		lp.pushError(fmt.Errorf("charger: %w", err))
+		lp.finishDecision(loadpoint.ReasonChargerError, err)
 		return
 	}
 
@@ -1458,12 +1591,24 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 		if lp.vehicleUnidentified() {
 			lp.identifyVehicleByStatus()
 		}
+
+		// find vehicle by charging behavior
+		lp.identifyVehicleBySignature()
+
+		// use vehicle data provided by charger if no vehicle was identified
+		lp.identifyChargerVehicle()
 	}
 
 	// publish soc after updating charger status to make sure
 	// initial update of connected state matches charger status
 	lp.publishSocAndRange()
 
+	// sync target soc with vehicle
+	lp.syncVehicleSocLimit()
+
+	// start climate ahead of departure
+	lp.preconditionVehicle()
+
 	// sync settings with charger
 	lp.syncCharger()
 
@@ -1473,14 +1618,22 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 	// track if remote disabled is actually active
 	remoteDisabled := loadpoint.RemoteEnable
 
+	// track if charge goal is reached
+	var targetReached bool
+
+	// branch taken for decision trace
+	var reason string
+
 	// execute loading strategy
 	switch {
 	case !lp.connected():
 		// always disable charger if not connected
 		// https://github.com/evcc-io/evcc/issues/105
 		err = lp.setLimit(0, false)
+		reason = loadpoint.ReasonDisconnected
 
 	case lp.scalePhasesRequired():
+		reason = loadpoint.ReasonPhaseSwitch
 		if err = lp.scalePhases(lp.ConfiguredPhases); err == nil {
 			lp.log.DEBUG.Printf("switched phases: %dp", lp.ConfiguredPhases)
 		}
@@ -1488,21 +1641,30 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 	case lp.targetEnergyReached():
 		lp.log.DEBUG.Printf("targetEnergy reached: %.0fkWh > %0.1fkWh", lp.getChargedEnergy()/1e3, lp.targetEnergy)
 		err = lp.disableUnlessClimater()
+		targetReached = true
+		reason = loadpoint.ReasonTargetEnergy
 
 	case lp.targetSocReached():
 		lp.log.DEBUG.Printf("targetSoc reached: %.1f%% > %d%%", lp.vehicleSoc, lp.Soc.target)
 		err = lp.disableUnlessClimater()
+		targetReached = true
+		reason = loadpoint.ReasonTargetSoc
 
 	case lp.remoteControlled(loadpoint.RemoteHardDisable):
 		remoteDisabled = loadpoint.RemoteHardDisable
+		reason = loadpoint.ReasonRemoteHardDisable
 		fallthrough
 
 	case mode == api.ModeOff:
 		err = lp.setLimit(0, true)
+		if reason == "" {
+			reason = loadpoint.ReasonOff
+		}
 
 	// immediate charging
 	case mode == api.ModeNow:
 		err = lp.fastCharging()
+		reason = loadpoint.ReasonNow
 
 	// minimum or target charging
 	case lp.minSocNotReached() || lp.plannerActive():
@@ -1510,21 +1672,29 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 		lp.resetPhaseTimer()
 		lp.elapsePVTimer() // let PV mode disable immediately afterwards
 
+		reason = loadpoint.ReasonPlanner
+		if lp.minSocNotReached() {
+			reason = loadpoint.ReasonMinSoc
+		}
+
 	case mode == api.ModeMinPV || mode == api.ModePV:
 		// cheap tariff
 		if autoCharge && lp.GetTargetTime().IsZero() {
 			err = lp.fastCharging()
 			lp.resetPhaseTimer()
 			lp.elapsePVTimer() // let PV mode disable immediately afterwards
+			reason = loadpoint.ReasonCheapTariff
 			break
 		}
 
 		targetCurrent := lp.pvMaxCurrent(mode, sitePower, batteryBuffered)
+		reason = lp.pvReason(targetCurrent)
 
 		var required bool // false
 		if targetCurrent == 0 && lp.vehicleClimateActive() {
 			targetCurrent = lp.GetMinCurrent()
 			required = true
+			reason = loadpoint.ReasonClimater
 		}
 
 		// Sunny Home Manager
@@ -1532,6 +1702,7 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 			remoteDisabled = loadpoint.RemoteSoftDisable
 			targetCurrent = 0
 			required = true
+			reason = loadpoint.ReasonRemoteSoftDisable
 		}
 
 		err = lp.setLimit(targetCurrent, required)
@@ -1548,8 +1719,16 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered bool)
 		lp.publish("remoteDisabled", remoteDisabled)
 	}
 
+	// charge goal notification
+	if lp.connected() {
+		lp.pushTargetReached(targetReached)
+	}
+
 	// log any error
 	if err != nil {
 		lp.log.ERROR.Println(err)
 	}
+	lp.pushError(err)
+
+	lp.finishDecision(reason, err)
 }
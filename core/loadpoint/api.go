@@ -55,6 +55,10 @@ type API interface {
 	GetTargetSoc() int
 	// SetTargetSoc sets the charge target soc
 	SetTargetSoc(int)
+	// GetVehicleSoc returns the vehicle soc
+	GetVehicleSoc() float64
+	// SetVehicleSoc sets the soc of a vehicle without soc api
+	SetVehicleSoc(float64) error
 	// GetPlannerUnit returns the planning tariffs unit
 	GetPlannerUnit() string
 	// GetPlan creates a charging plan
@@ -71,6 +75,9 @@ type API interface {
 	// RemoteControl sets remote status demand
 	RemoteControl(string, RemoteDemand)
 
+	// GetTrace returns the most recent update cycle decisions
+	GetTrace() []Decision
+
 	//
 	// power and energy
 	//
@@ -0,0 +1,53 @@
+package loadpoint
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// Decision reasons explaining the charging state of a loadpoint update cycle
+const (
+	ReasonChargerError      = "chargerError"
+	ReasonDisconnected      = "disconnected"
+	ReasonPhaseSwitch       = "phaseSwitch"
+	ReasonTargetEnergy      = "targetEnergyReached"
+	ReasonTargetSoc         = "targetSocReached"
+	ReasonRemoteHardDisable = "remoteHardDisable"
+	ReasonOff               = "off"
+	ReasonNow               = "now"
+	ReasonMinSoc            = "minSoc"
+	ReasonPlanner           = "plannerSlot"
+	ReasonCheapTariff       = "cheapTariff"
+	ReasonRemoteSoftDisable = "remoteSoftDisable"
+	ReasonClimater          = "climater"
+	ReasonPhaseSwitchTimer  = "phaseSwitchPending"
+	ReasonPVEnableTimer     = "pvEnableTimer"
+	ReasonPVDisableTimer    = "pvDisableTimer"
+	ReasonPVInsufficient    = "pvInsufficient"
+	ReasonPVMinCurrent      = "pvMinCurrent"
+	ReasonPV                = "pv"
+)
+
+// Decision is the structured record of a single loadpoint update cycle
+type Decision struct {
+	Time   time.Time        `json:"time"`
+	Reason string           `json:"reason"` // branch taken
+	Mode   api.ChargeMode   `json:"mode"`
+	Status api.ChargeStatus `json:"status"`
+
+	// inputs
+	SitePower       float64 `json:"sitePower"`
+	AutoCharge      bool    `json:"autoCharge"`
+	BatteryBuffered bool    `json:"batteryBuffered"`
+	VehicleSoc      float64 `json:"vehicleSoc"`
+	MinSoc          int     `json:"minSoc"`
+	TargetSoc       int     `json:"targetSoc"`
+	Phases          int     `json:"phases"`
+	TimerRemaining  float64 `json:"timerRemaining,omitempty"` // s
+
+	// result
+	Enabled bool    `json:"enabled"`
+	Current float64 `json:"current"` // A
+	Error   string  `json:"error,omitempty"`
+}
@@ -303,6 +303,20 @@ func (mr *MockAPIMockRecorder) GetTargetTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargetTime", reflect.TypeOf((*MockAPI)(nil).GetTargetTime))
 }
 
+// GetTrace mocks base method.
+func (m *MockAPI) GetTrace() []Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrace")
+	ret0, _ := ret[0].([]Decision)
+	return ret0
+}
+
+// GetTrace indicates an expected call of GetTrace.
+func (mr *MockAPIMockRecorder) GetTrace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrace", reflect.TypeOf((*MockAPI)(nil).GetTrace))
+}
+
 // GetVehicle mocks base method.
 func (m *MockAPI) GetVehicle() api.Vehicle {
 	m.ctrl.T.Helper()
@@ -317,6 +331,20 @@ func (mr *MockAPIMockRecorder) GetVehicle() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicle", reflect.TypeOf((*MockAPI)(nil).GetVehicle))
 }
 
+// GetVehicleSoc mocks base method.
+func (m *MockAPI) GetVehicleSoc() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVehicleSoc")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// GetVehicleSoc indicates an expected call of GetVehicleSoc.
+func (mr *MockAPIMockRecorder) GetVehicleSoc() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicleSoc", reflect.TypeOf((*MockAPI)(nil).GetVehicleSoc))
+}
+
 // HasChargeMeter mocks base method.
 func (m *MockAPI) HasChargeMeter() bool {
 	m.ctrl.T.Helper()
@@ -493,6 +521,20 @@ func (mr *MockAPIMockRecorder) SetVehicle(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVehicle", reflect.TypeOf((*MockAPI)(nil).SetVehicle), arg0)
 }
 
+// SetVehicleSoc mocks base method.
+func (m *MockAPI) SetVehicleSoc(arg0 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVehicleSoc", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVehicleSoc indicates an expected call of SetVehicleSoc.
+func (mr *MockAPIMockRecorder) SetVehicleSoc(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVehicleSoc", reflect.TypeOf((*MockAPI)(nil).SetVehicleSoc), arg0)
+}
+
 // StartVehicleDetection mocks base method.
 func (m *MockAPI) StartVehicleDetection() {
 	m.ctrl.T.Helper()
@@ -204,7 +204,7 @@ func (lp *Loadpoint) GetTargetTime() time.Time {
 
 // SetTargetTime sets the charge target time
 func (lp *Loadpoint) SetTargetTime(finishAt time.Time) error {
-	if !finishAt.IsZero() && finishAt.Before(time.Now()) {
+	if !finishAt.IsZero() && finishAt.Before(lp.clock.Now()) {
 		return errors.New("timestamp is in the past")
 	}
 
@@ -423,6 +423,36 @@ func (lp *Loadpoint) SetVehicle(vehicle api.Vehicle) {
 	lp.stopVehicleDetection()
 }
 
+// GetVehicleSoc returns the vehicle soc
+func (lp *Loadpoint) GetVehicleSoc() float64 {
+	lp.Lock()
+	defer lp.Unlock()
+	return lp.vehicleSoc
+}
+
+// SetVehicleSoc sets the soc of a vehicle without soc api
+func (lp *Loadpoint) SetVehicleSoc(soc float64) error {
+	if soc < 0 || soc > 100 {
+		return errors.New("invalid soc")
+	}
+
+	lp.Lock()
+	defer lp.Unlock()
+
+	if lp.socEstimator == nil || !lp.vehicleHasFeature(api.Offline) {
+		return errors.New("vehicle soc not settable")
+	}
+
+	lp.log.DEBUG.Printf("set vehicle soc: %.0f%%", soc)
+
+	// apply on update loop as the estimator is not safe for concurrent use
+	lp.vehicleSocRequest = &soc
+
+	lp.requestUpdate()
+
+	return nil
+}
+
 // StartVehicleDetection allows triggering vehicle detection for debugging purposes
 func (lp *Loadpoint) StartVehicleDetection() {
 	// reset vehicle
@@ -2,9 +2,56 @@ package core
 
 import (
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/wrapper"
 	"golang.org/x/exp/slices"
 )
 
+// updateDevices replaces charger and charge meter that failed to initialize
+// with the live devices once available to pick up their capabilities
+func (lp *Loadpoint) updateDevices() {
+	if mt, ok := liveDevice(lp.chargeMeter); ok {
+		lp.chargeMeter = mt
+	}
+
+	charger, ok := liveDevice(lp.charger)
+	if !ok {
+		return
+	}
+
+	lp.Lock()
+	lp.charger = charger
+	lp.Unlock()
+
+	// charge meter and rater are provided by the charger if no charge meter is configured
+	if _, integrated := lp.chargeMeter.(*wrapper.ChargeMeter); integrated {
+		if mt, ok := charger.(api.Meter); ok {
+			lp.chargeMeter = mt
+		}
+
+		if rt, ok := charger.(api.ChargeRater); ok {
+			lp.chargeRater = rt
+
+			if f, err := rt.ChargedEnergy(); err == nil {
+				lp.chargedAtStartup = f
+			}
+		}
+	}
+
+	if ct, ok := charger.(api.ChargeTimer); ok {
+		lp.chargeTimer = ct
+	}
+
+	if lp.vehicle != nil {
+		lp.socEstimator = lp.newSocEstimator(lp.vehicle)
+		lp.restoreVehicleSoc()
+	}
+
+	lp.publishChargerFeature(api.IntegratedDevice)
+	if c, ok := charger.(api.IconDescriber); ok {
+		lp.publish(chargerIcon, c.Icon())
+	}
+}
+
 // chargerHasFeature checks availability of charger feature
 func (lp *Loadpoint) chargerHasFeature(f api.Feature) bool {
 	c, ok := lp.charger.(api.FeatureDescriber)
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Control strategies
+const (
+	controlProportional = "proportional"
+	controlPI           = "pi"
+
+	piMaxInterval = time.Minute // reset controller if not updated for this long
+)
+
+// ControlConfig defines the pv surplus control strategy
+type ControlConfig struct {
+	Strategy string        `mapstructure:"strategy"` // proportional (default) or pi
+	Kp       float64       `mapstructure:"kp"`       // pi: proportional gain
+	Ki       float64       `mapstructure:"ki"`       // pi: integral gain per second
+	Offset   float64       `mapstructure:"offset"`   // pi: target grid power (W), negative for export
+	Lag      time.Duration `mapstructure:"lag"`      // pi: charger response time for lag compensation
+}
+
+// piController is a PI controller for the pv charge current with anti-windup and charger lag compensation.
+// All values are currents in A.
+type piController struct {
+	kp, ki float64
+	lag    time.Duration
+
+	integral float64   // integral term
+	setpoint float64   // last output
+	changed  time.Time // last setpoint change
+	updated  time.Time // last update
+}
+
+// newPIController creates a PI controller, applying default gains
+func newPIController(cc ControlConfig) *piController {
+	c := &piController{
+		kp:  cc.Kp,
+		ki:  cc.Ki,
+		lag: cc.Lag,
+	}
+
+	if c.kp == 0 {
+		c.kp = 0.5
+	}
+	if c.ki == 0 {
+		c.ki = 0.05
+	}
+
+	return c
+}
+
+// configureControl validates the control strategy and creates the controller
+func (lp *Loadpoint) configureControl() error {
+	switch lp.Control.Strategy = strings.ToLower(lp.Control.Strategy); lp.Control.Strategy {
+	case "", controlProportional:
+		if lp.Control.Offset != 0 {
+			lp.log.WARN.Println("control offset requires pi strategy")
+		}
+	case controlPI:
+		lp.pi = newPIController(lp.Control)
+	default:
+		return fmt.Errorf("invalid control strategy: %s", lp.Control.Strategy)
+	}
+
+	return nil
+}
+
+// reset re-initializes the controller for bumpless transfer from the given current
+func (c *piController) reset(current float64) {
+	c.integral = current
+	c.setpoint = current
+	c.changed = time.Time{}
+	c.updated = time.Time{}
+}
+
+// update returns the charge current for the given control error.
+// Effective is the charge current actually drawn, used for lag compensation and reset.
+func (c *piController) update(now time.Time, deviation, effective, minCurrent, maxCurrent float64) float64 {
+	if !c.updated.IsZero() && now.Sub(c.updated) > piMaxInterval {
+		c.reset(effective)
+	}
+
+	var dt float64
+	if !c.updated.IsZero() {
+		dt = now.Sub(c.updated).Seconds()
+	} else {
+		c.integral = effective
+	}
+	c.updated = now
+
+	// charger has not followed the last setpoint yet, anticipate the pending change
+	if c.lag > 0 && now.Sub(c.changed) < c.lag {
+		deviation -= c.setpoint - effective
+	}
+
+	out := c.integral + c.kp*deviation
+
+	// anti-windup: don't integrate further into saturation
+	if !(out >= maxCurrent && deviation > 0 || out <= minCurrent && deviation < 0) {
+		c.integral = math.Min(math.Max(c.integral+c.ki*deviation*dt, minCurrent), maxCurrent)
+	}
+
+	out = math.Min(math.Max(out, 0), maxCurrent)
+
+	if out != c.setpoint {
+		c.setpoint = out
+		c.changed = now
+	}
+
+	return out
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+// simulate runs the controller against a charger following its setpoint with given lag factor
+func simulate(t *testing.T, c *piController, clck *clock.Mock, surplus func(int) float64, cycles int, follow float64) []float64 {
+	t.Helper()
+
+	Voltage = 230 // V
+
+	var effective float64
+	res := make([]float64, 0, cycles)
+
+	for i := 0; i < cycles; i++ {
+		sitePower := effective*Voltage - surplus(i)
+		deviation := powerToCurrent(-sitePower, 1)
+
+		setpoint := c.update(clck.Now(), deviation, effective, 6, 16)
+		res = append(res, setpoint)
+
+		// lagging charger
+		effective += follow * (setpoint - effective)
+		clck.Add(10 * time.Second)
+	}
+
+	return res
+}
+
+func TestPIControllerConverges(t *testing.T) {
+	clck := clock.NewMock()
+	c := newPIController(ControlConfig{Lag: 20 * time.Second})
+
+	// 10A surplus
+	res := simulate(t, c, clck, func(int) float64 { return 10 * Voltage }, 40, 0.5)
+
+	final := res[len(res)-1]
+	assert.InDelta(t, 10, final, 0.2)
+
+	// no excessive overshoot
+	for _, v := range res {
+		assert.LessOrEqual(t, v, 11.0)
+	}
+}
+
+func TestPIControllerAntiWindup(t *testing.T) {
+	clck := clock.NewMock()
+	c := newPIController(ControlConfig{})
+
+	// large surplus saturates at max current
+	res := simulate(t, c, clck, func(int) float64 { return 30 * Voltage }, 30, 1)
+
+	assert.Equal(t, 16.0, res[len(res)-1])
+	assert.Less(t, c.integral, 16.0) // integration stopped at saturation
+
+	// surplus drops to 8A while charging at 16A, output follows immediately
+	deviation := powerToCurrent(-(16-8)*Voltage, 1)
+	assert.Less(t, c.update(clck.Now(), deviation, 16, 6, 16), 13.0)
+}
+
+func TestPIControllerReset(t *testing.T) {
+	clck := clock.NewMock()
+	c := newPIController(ControlConfig{Kp: 1, Ki: 0.1})
+
+	// first update starts from effective current
+	assert.Equal(t, 8.0, c.update(clck.Now(), 0, 8, 6, 16))
+
+	// stale controller restarts from effective current
+	clck.Add(2 * piMaxInterval)
+	assert.Equal(t, 10.0, c.update(clck.Now(), 0, 10, 6, 16))
+	assert.False(t, math.IsNaN(c.integral))
+}
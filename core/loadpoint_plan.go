@@ -18,6 +18,9 @@ func (lp *Loadpoint) setPlanActive(active bool) {
 	if !active {
 		lp.planSlotEnd = time.Time{}
 	}
+	if active && !lp.planActive {
+		lp.pushEvent(evPlanStart)
+	}
 	lp.planActive = active
 	lp.publish(planActive, lp.planActive)
 }
@@ -32,6 +35,11 @@ func (lp *Loadpoint) planRequiredDuration(maxPower float64) time.Duration {
 		return 0
 	}
 
+	// learned vehicle charge power
+	if power := lp.socEstimator.MaxPower(lp.maxActivePhases()); power > 0 && power < maxPower {
+		maxPower = power
+	}
+
 	// TODO vehicle soc limit
 	targetSoc := lp.Soc.target
 	if targetSoc == 0 {
@@ -43,6 +51,11 @@ func (lp *Loadpoint) planRequiredDuration(maxPower float64) time.Duration {
 		return 0
 	}
 
+	// learned charge curve already includes lower charge rates
+	if lp.socEstimator.Tapered() {
+		return requiredDuration
+	}
+
 	// anticipate lower charge rates at end of charging curve
 	var additionalDuration time.Duration
 
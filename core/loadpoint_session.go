@@ -31,6 +31,7 @@ func (lp *Loadpoint) createSession() {
 	}
 
 	lp.session = lp.db.Session(lp.chargeMeterTotal())
+	lp.sessionEnergy, lp.sessionSolarEnergy, lp.sessionCost = 0, 0, 0
 
 	if lp.vehicle != nil {
 		lp.session.Vehicle = lp.vehicle.Title()
@@ -62,9 +63,30 @@ func (lp *Loadpoint) stopSession() {
 		lp.session.ChargedEnergy = chargedEnergy
 	}
 
+	if lp.sessionEnergy > 0 {
+		lp.session.SolarPercentage = 100 * lp.sessionSolarEnergy / lp.sessionEnergy
+		lp.session.Price = lp.sessionCost
+		lp.session.PricePerKWh = lp.sessionCost / lp.sessionEnergy
+	}
+
 	lp.db.Persist(lp.session)
 }
 
+// accountSessionEnergy attributes the energy charged since the last call to solar share and price
+func (lp *Loadpoint) accountSessionEnergy(greenShare, price float64) {
+	if lp.session == nil {
+		return
+	}
+
+	energy := lp.getChargedEnergy() / 1e3
+	if delta := energy - lp.sessionEnergy; delta > 0 {
+		lp.sessionSolarEnergy += delta * greenShare
+		lp.sessionCost += delta * price
+	}
+
+	lp.sessionEnergy = energy
+}
+
 type sessionOption func(*db.Session)
 
 // updateSession updates any parameter of a charging session and persists the session.
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -779,3 +780,38 @@ func TestMinSoc(t *testing.T) {
 		assert.Equal(t, tc.res, lp.minSocNotReached(), tc)
 	}
 }
+
+func TestPushError(t *testing.T) {
+	clck := clock.NewMock()
+	pushChan := make(chan push.Event, 10)
+
+	lp := &Loadpoint{
+		clock:    clck,
+		pushChan: pushChan,
+	}
+
+	err := errors.New("foo")
+
+	lp.pushError(err)
+	assert.Len(t, pushChan, 1)
+
+	// flapping error is not sent again
+	for i := 0; i < 3; i++ {
+		clck.Add(time.Minute)
+		lp.pushError(nil)
+		clck.Add(time.Minute)
+		lp.pushError(err)
+	}
+	assert.Len(t, pushChan, 1)
+
+	// different error is sent
+	lp.pushError(errors.New("bar"))
+	assert.Len(t, pushChan, 2)
+
+	// error is sent again after being cleared
+	lp.pushError(nil)
+	clck.Add(errorClearPeriod)
+	lp.pushError(nil)
+	lp.pushError(err)
+	assert.Len(t, pushChan, 3)
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"math"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+)
+
+// traceSize is the number of decisions kept per loadpoint
+const traceSize = 50
+
+// startDecision records the inputs of the current update cycle
+func (lp *Loadpoint) startDecision(mode api.ChargeMode, sitePower float64, autoCharge, batteryBuffered bool) {
+	lp.decision = loadpoint.Decision{
+		Time:            lp.clock.Now(),
+		Mode:            mode,
+		SitePower:       sitePower,
+		AutoCharge:      autoCharge,
+		BatteryBuffered: batteryBuffered,
+	}
+}
+
+// pvReason explains the pv charge current from the pv and phase timers
+func (lp *Loadpoint) pvReason(targetCurrent float64) string {
+	switch {
+	case !lp.phaseTimer.IsZero():
+		lp.decision.TimerRemaining = lp.timerRemaining(lp.phaseTimer, lp.Enable.Delay, lp.Disable.Delay)
+		return loadpoint.ReasonPhaseSwitchTimer
+
+	case !lp.pvTimer.IsZero() && lp.enabled:
+		lp.decision.TimerRemaining = lp.timerRemaining(lp.pvTimer, lp.Disable.Delay)
+		return loadpoint.ReasonPVDisableTimer
+
+	case !lp.pvTimer.IsZero():
+		lp.decision.TimerRemaining = lp.timerRemaining(lp.pvTimer, lp.Enable.Delay)
+		return loadpoint.ReasonPVEnableTimer
+
+	case targetCurrent == 0:
+		return loadpoint.ReasonPVInsufficient
+
+	case targetCurrent <= lp.GetMinCurrent():
+		return loadpoint.ReasonPVMinCurrent
+
+	default:
+		return loadpoint.ReasonPV
+	}
+}
+
+// timerRemaining returns the remaining timer duration in seconds, using the shortest pending delay
+func (lp *Loadpoint) timerRemaining(timer time.Time, delays ...time.Duration) float64 {
+	elapsed := lp.clock.Since(timer)
+
+	res := math.MaxFloat64
+	for _, delay := range delays {
+		if remaining := (delay - elapsed).Seconds(); remaining >= 0 {
+			res = math.Min(res, remaining)
+		}
+	}
+
+	if res == math.MaxFloat64 {
+		return 0
+	}
+
+	return math.Round(res)
+}
+
+// finishDecision records the result of the current update cycle, publishes the reason and adds it to the trace
+func (lp *Loadpoint) finishDecision(reason string, err error) {
+	d := &lp.decision
+
+	d.Reason = reason
+	d.Status = lp.status
+	d.VehicleSoc = lp.vehicleSoc
+	d.MinSoc = lp.GetMinSoc()
+	d.TargetSoc = lp.GetTargetSoc()
+	d.Phases = lp.activePhases()
+	d.Enabled = lp.enabled
+	if lp.enabled {
+		d.Current = lp.chargeCurrent
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+
+	lp.log.DEBUG.Printf("decision: %s", reason)
+	lp.publish("reason", reason)
+
+	lp.traceMu.Lock()
+	defer lp.traceMu.Unlock()
+
+	if len(lp.trace) >= traceSize {
+		lp.trace = lp.trace[1:]
+	}
+	lp.trace = append(lp.trace, *d)
+}
+
+// GetTrace returns the most recent update cycle decisions
+func (lp *Loadpoint) GetTrace() []loadpoint.Decision {
+	lp.traceMu.Lock()
+	defer lp.traceMu.Unlock()
+
+	return append([]loadpoint.Decision{}, lp.trace...)
+}
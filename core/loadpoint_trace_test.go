@@ -0,0 +1,125 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceRingBuffer(t *testing.T) {
+	lp := &Loadpoint{
+		log:   util.NewLogger("foo"),
+		clock: clock.NewMock(),
+	}
+
+	for i := 0; i <= traceSize; i++ {
+		lp.startDecision(api.ModePV, float64(i), false, false)
+		lp.finishDecision(loadpoint.ReasonPV, nil)
+	}
+
+	trace := lp.GetTrace()
+	assert.Len(t, trace, traceSize)
+	assert.Equal(t, 1.0, trace[0].SitePower)
+	assert.Equal(t, float64(traceSize), trace[traceSize-1].SitePower)
+}
+
+func TestPVReason(t *testing.T) {
+	clock := clock.NewMock()
+
+	lp := &Loadpoint{
+		clock:      clock,
+		MinCurrent: minA,
+		Enable:     ThresholdConfig{Delay: time.Minute},
+		Disable:    ThresholdConfig{Delay: 3 * time.Minute},
+	}
+
+	assert.Equal(t, loadpoint.ReasonPVInsufficient, lp.pvReason(0))
+	assert.Equal(t, loadpoint.ReasonPVMinCurrent, lp.pvReason(minA))
+	assert.Equal(t, loadpoint.ReasonPV, lp.pvReason(maxA))
+
+	lp.pvTimer = clock.Now()
+	clock.Add(20 * time.Second)
+	assert.Equal(t, loadpoint.ReasonPVEnableTimer, lp.pvReason(0))
+	assert.Equal(t, 40.0, lp.decision.TimerRemaining)
+
+	lp.enabled = true
+	assert.Equal(t, loadpoint.ReasonPVDisableTimer, lp.pvReason(minA))
+	assert.Equal(t, 160.0, lp.decision.TimerRemaining)
+
+	lp.phaseTimer = clock.Now()
+	assert.Equal(t, loadpoint.ReasonPhaseSwitchTimer, lp.pvReason(0))
+	assert.Equal(t, 60.0, lp.decision.TimerRemaining)
+}
+
+func TestTraceTargetSoc(t *testing.T) {
+	clock := clock.NewMock()
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	vehicle.EXPECT().Capacity().Return(float64(10))
+	vehicle.EXPECT().Phases().Return(0).AnyTimes()
+	socEstimator := soc.NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
+
+	lp := &Loadpoint{
+		log:          util.NewLogger("foo"),
+		bus:          evbus.New(),
+		clock:        clock,
+		charger:      charger,
+		chargeMeter:  &Null{},            // silence nil panics
+		chargeRater:  &Null{},            // silence nil panics
+		chargeTimer:  &Null{},            // silence nil panics
+		progress:     NewProgress(0, 10), // silence nil panics
+		wakeUpTimer:  NewTimer(),         // silence nil panics
+		MinCurrent:   minA,
+		MaxCurrent:   maxA,
+		vehicle:      vehicle,
+		socEstimator: socEstimator,
+		Mode:         api.ModeNow,
+		Soc: SocConfig{
+			target: 90,
+			Poll: PollConfig{
+				Mode:     pollConnected,
+				Interval: pollInterval,
+			},
+		},
+	}
+
+	attachListeners(t, lp)
+
+	lp.enabled = true
+	lp.chargeCurrent = float64(minA)
+	lp.status = api.StatusC
+
+	// charging below target soc
+	vehicle.EXPECT().Soc().Return(85.0, nil)
+	charger.EXPECT().Status().Return(api.StatusC, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().MaxCurrent(int64(maxA)).Return(nil)
+	lp.Update(500, false, false)
+	assert.Equal(t, loadpoint.ReasonNow, lp.decision.Reason)
+	assert.Equal(t, maxA, lp.decision.Current)
+
+	// target soc reached
+	clock.Add(5 * time.Minute)
+	vehicle.EXPECT().Soc().Return(90.0, nil)
+	charger.EXPECT().Status().Return(api.StatusC, nil)
+	charger.EXPECT().Enabled().Return(lp.enabled, nil)
+	charger.EXPECT().Enable(false).Return(nil)
+	lp.Update(500, false, false)
+	assert.Equal(t, loadpoint.ReasonTargetSoc, lp.decision.Reason)
+	assert.False(t, lp.decision.Enabled)
+
+	trace := lp.GetTrace()
+	assert.Len(t, trace, 2)
+	assert.Equal(t, loadpoint.ReasonNow, trace[0].Reason)
+}
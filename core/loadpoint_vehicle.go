@@ -2,20 +2,30 @@ package core
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/coordinator"
 	"github.com/evcc-io/evcc/core/db"
+	"github.com/evcc-io/evcc/core/quota"
 	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/core/wrapper"
 	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/server/db/settings"
 	"golang.org/x/exp/slices"
 )
 
 const (
 	vehicleDetectInterval = 1 * time.Minute
 	vehicleDetectDuration = 10 * time.Minute
+
+	vehicleSignatureInterval = 5 * time.Minute
+
+	vehicleRetryDelay = 5 * time.Minute // delay before repeating failed vehicle commands
 )
 
 // coordinatedVehicles is the slice of vehicles from the coordinator
@@ -64,6 +74,24 @@ func (lp *Loadpoint) identifyVehicle() {
 	}
 }
 
+// identifyChargerVehicle activates an implicit vehicle from charger-provided vehicle data
+// if no configured vehicle has been identified
+func (lp *Loadpoint) identifyChargerVehicle() {
+	if lp.vehicle != nil || lp.chargerVehicle || !lp.vehicleDetect.IsZero() {
+		return
+	}
+
+	if _, err := lp.chargerSoc(); err != nil {
+		return
+	}
+
+	vehicle := wrapper.NewChargerVehicle(lp.charger, lp.vehicleIdentifier)
+	lp.log.INFO.Printf("using vehicle data from charger: %s", vehicle.Title())
+
+	lp.chargerVehicle = true
+	lp.setActiveVehicle(vehicle)
+}
+
 // selectVehicleByID selects the vehicle with the given ID
 func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 	vehicles := lp.coordinatedVehicles()
@@ -96,6 +124,31 @@ func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 	return nil
 }
 
+// replaceVehicle replaces a vehicle that failed to initialize with the live vehicle
+func (lp *Loadpoint) replaceVehicle(old, vehicle api.Vehicle) {
+	if lp.defaultVehicle == old {
+		lp.defaultVehicle = vehicle
+	}
+
+	lp.Lock()
+	active := lp.vehicle == old
+	lp.Unlock()
+
+	if active {
+		lp.setActiveVehicle(vehicle)
+	}
+}
+
+// newSocEstimator creates the soc estimator for the charger and vehicle
+func (lp *Loadpoint) newSocEstimator(vehicle api.Vehicle) *soc.Estimator {
+	// resolve optional config
+	var estimate bool
+	if lp.Soc.Estimate == nil || *lp.Soc.Estimate {
+		estimate = true
+	}
+	return soc.NewEstimator(lp.log, lp.charger, vehicle, estimate)
+}
+
 // setActiveVehicle assigns currently active vehicle, configures soc estimator
 // and adds an odometer task
 func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
@@ -119,6 +172,10 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 	lp.log.INFO.Printf("vehicle updated: %s -> %s", from, to)
 
 	lp.vehicle = vehicle
+	lp.vehicleSocLimit = 0
+	lp.vehicleSocLimitLast = 0
+	lp.vehicleSocLimitRetry = time.Time{}
+	lp.vehicleSocRequest = nil
 
 	// reset minSoc and targetSoc before change
 	lp.setMinSoc(0)
@@ -133,12 +190,8 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 	if vehicle != nil {
 		lp.socUpdated = time.Time{}
 
-		// resolve optional config
-		var estimate bool
-		if lp.Soc.Estimate == nil || *lp.Soc.Estimate {
-			estimate = true
-		}
-		lp.socEstimator = soc.NewEstimator(lp.log, lp.charger, vehicle, estimate)
+		lp.socEstimator = lp.newSocEstimator(vehicle)
+		lp.restoreVehicleSoc()
 
 		lp.publish(vehiclePresent, true)
 		lp.publish(vehicleTitle, lp.vehicle.Title())
@@ -148,6 +201,8 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 		lp.applyAction(vehicle.OnIdentified())
 		lp.addTask(lp.vehicleOdometer)
 
+		lp.pushEvent(evVehicleIdentified)
+
 		lp.progress.Reset()
 	} else {
 		lp.socEstimator = nil
@@ -184,7 +239,7 @@ func (lp *Loadpoint) wakeUpVehicle() {
 
 	// vehicle
 	if vs, ok := lp.vehicle.(api.Resurrector); ok {
-		if err := vs.WakeUp(); err != nil {
+		if err := vs.WakeUp(); err != nil && !errors.Is(err, api.ErrNotAvailable) {
 			lp.log.ERROR.Printf("wake-up vehicle: %v", err)
 		}
 	}
@@ -265,6 +320,10 @@ func (lp *Loadpoint) startVehicleDetection() {
 	lp.log.DEBUG.Println("vehicle api refresh")
 	provider.ResetCached()
 
+	// signature soc baselines start with the new session
+	lp.coordinator.ResetSignature()
+	lp.signatureUpdated = time.Time{}
+
 	lp.vehicleDetect = lp.clock.Now()
 	lp.vehicleDetectTicker = lp.clock.Ticker(vehicleDetectInterval)
 	lp.publish(vehicleDetectionActive, true)
@@ -292,11 +351,96 @@ func (lp *Loadpoint) identifyVehicleByStatus() {
 	}
 
 	// remove previous vehicle if status was not confirmed
-	if _, ok := lp.vehicle.(api.ChargeState); ok {
+	if vs, ok := lp.vehicle.(api.ChargeState); ok {
+		// vehicle status not available (yet)
+		if _, err := vs.Status(); errors.Is(err, api.ErrNotAvailable) {
+			return
+		}
 		lp.setActiveVehicle(nil)
 	}
 }
 
+// identifyVehicleBySignature selects the vehicle matching the observed charging behavior
+// if neither identifier nor status did identify a vehicle
+func (lp *Loadpoint) identifyVehicleBySignature() {
+	if lp.vehicle != nil || !lp.charging() || len(lp.coordinatedVehicles()) == 0 {
+		return
+	}
+
+	if lp.clock.Since(lp.signatureUpdated) < vehicleSignatureInterval {
+		return
+	}
+	lp.signatureUpdated = lp.clock.Now()
+
+	var current float64
+	for _, i := range lp.chargeCurrents {
+		current = math.Max(current, i)
+	}
+
+	sig := coordinator.Signature{
+		Phases:  lp.getMeasuredPhases(),
+		Current: current,
+		Offered: lp.chargeCurrent,
+		Energy:  lp.getChargedEnergy() / 1e3,
+	}
+
+	vehicle, confidence := lp.coordinator.IdentifyVehicleBySignature(sig)
+	if vehicle == nil {
+		return
+	}
+
+	lp.log.INFO.Printf("vehicle identified by charging signature: %s (%.0f%% confidence)", vehicle.Title(), 100*confidence)
+
+	lp.stopVehicleDetection()
+	lp.setActiveVehicle(vehicle)
+}
+
+// syncVehicleSocLimit pushes the loadpoint's target soc to the vehicle's charge limit
+func (lp *Loadpoint) syncVehicleSocLimit() {
+	vs, ok := lp.vehicle.(api.SocLimitSetter)
+	if !ok || !lp.connected() {
+		return
+	}
+
+	targetSoc := lp.Soc.target
+	if targetSoc == 0 {
+		targetSoc = 100
+	}
+
+	if targetSoc == lp.vehicleSocLimit || lp.clock.Now().Before(lp.vehicleSocLimitRetry) {
+		return
+	}
+
+	if !lp.vehicleQuotaAllowed() {
+		return
+	}
+
+	err := vs.SetTargetSoc(int64(targetSoc))
+	lp.vehicleQuotaDone(err)
+
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle soc limit: %v", err)
+		}
+		lp.vehicleSocLimitRetry = lp.clock.Now().Add(vehicleRetryDelay)
+		return
+	}
+
+	lp.log.DEBUG.Printf("vehicle soc limit set: %d%%", targetSoc)
+	lp.vehicleSocLimit = targetSoc
+}
+
+// vehicleSocLimitReported re-syncs the vehicle soc limit if it was lowered on the vehicle side.
+// Only changes of the reported limit are considered since cached vehicle data may still
+// report the limit from before the loadpoint's last write.
+func (lp *Loadpoint) vehicleSocLimitReported(limit int) {
+	if lp.vehicleSocLimitLast != 0 && limit != lp.vehicleSocLimitLast && limit < lp.vehicleSocLimit {
+		lp.vehicleSocLimit = 0
+	}
+
+	lp.vehicleSocLimitLast = limit
+}
+
 // vehicleOdometer updates odometer
 func (lp *Loadpoint) vehicleOdometer() {
 	if vs, ok := lp.vehicle.(api.VehicleOdometer); ok {
@@ -354,8 +498,40 @@ func (lp *Loadpoint) vehicleSocPollAllowed() bool {
 	return false
 }
 
+// vehicleQuotaAllowed checks if the vehicle api quota allows polling
+func (lp *Loadpoint) vehicleQuotaAllowed() bool {
+	if quota.Instance == nil || lp.vehicle == nil {
+		return true
+	}
+
+	if !quota.Instance.Allow(lp.vehicle, lp.charging()) {
+		lp.log.DEBUG.Println("vehicle api quota: poll deferred")
+		return false
+	}
+
+	return true
+}
+
+// vehicleQuotaDone accounts a vehicle api request and publishes the remaining quota
+func (lp *Loadpoint) vehicleQuotaDone(err error) {
+	if quota.Instance == nil || lp.vehicle == nil {
+		return
+	}
+
+	quota.Instance.Done(lp.vehicle, err)
+
+	if remaining, ok := quota.Instance.Remaining(lp.vehicle); ok {
+		lp.publish(vehicleQuota, remaining)
+	}
+}
+
 // vehicleClimateActive checks if vehicle has active climate request
 func (lp *Loadpoint) vehicleClimateActive() bool {
+	if lp.preconditioning() {
+		lp.publish("climaterActive", true)
+		return true
+	}
+
 	if cl, ok := lp.vehicle.(api.VehicleClimater); ok && lp.vehicleClimatePollAllowed() {
 		active, err := cl.Climater()
 		if err == nil {
@@ -374,3 +550,100 @@ func (lp *Loadpoint) vehicleClimateActive() bool {
 
 	return false
 }
+
+// preconditioning returns true while departure climate preconditioning is active
+func (lp *Loadpoint) preconditioning() bool {
+	return !lp.preconditionTime.IsZero() && lp.clock.Now().Before(lp.preconditionTime) && lp.connected()
+}
+
+// preconditionVehicle starts climatisation ahead of the target time while the vehicle is connected
+func (lp *Loadpoint) preconditionVehicle() {
+	vc, ok := lp.vehicle.(api.VehicleClimateController)
+	if !ok || lp.Precondition <= 0 {
+		return
+	}
+
+	targetTime := lp.GetTargetTime()
+
+	// target time changed or removed while preconditioning
+	if lp.preconditioning() && !targetTime.Equal(lp.preconditionTime) {
+		if err := vc.Climatize(false); err != nil {
+			lp.log.ERROR.Printf("precondition: %v", err)
+		}
+		lp.preconditionTime = time.Time{}
+	}
+
+	if targetTime.IsZero() || targetTime.Equal(lp.preconditionTime) || !lp.connected() || lp.GetMode() == api.ModeOff {
+		return
+	}
+
+	now := lp.clock.Now()
+	if now.Before(targetTime.Add(-lp.Precondition)) || !now.Before(targetTime) {
+		return
+	}
+
+	// climate must be supplied by the charger, not the vehicle battery
+	if !lp.enabled {
+		if remaining := (lp.GuardDuration - lp.clock.Since(lp.guardUpdated)).Truncate(time.Second); remaining > 0 {
+			lp.log.DEBUG.Printf("precondition: charger guard remaining %v", remaining)
+			return
+		}
+	}
+
+	if now.Before(lp.preconditionRetry) {
+		return
+	}
+
+	if err := vc.Climatize(true); err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("precondition: %v", err)
+		}
+		lp.preconditionRetry = now.Add(vehicleRetryDelay)
+		return
+	}
+
+	lp.log.INFO.Printf("precondition: climate started for departure at %s", targetTime.Round(time.Second).Local())
+
+	lp.preconditionTime = targetTime
+	lp.pushEvent(evPrecondition)
+}
+
+// vehicleSocSetting returns the settings key of the vehicle's last estimated soc
+func vehicleSocSetting(vehicle api.Vehicle) string {
+	return fmt.Sprintf("vehicle.%s.soc", vehicle.Title())
+}
+
+// restoreVehicleSoc restores the last estimated soc of a vehicle without soc api
+func (lp *Loadpoint) restoreVehicleSoc() {
+	if lp.socEstimator == nil || !lp.vehicleHasFeature(api.Offline) {
+		return
+	}
+
+	if soc, err := settings.Float(vehicleSocSetting(lp.vehicle)); err == nil {
+		lp.log.DEBUG.Printf("vehicle soc restored: %.0f%%", soc)
+		lp.socEstimator.SetSoc(soc, lp.getChargedEnergy())
+	}
+}
+
+// applyVehicleSoc applies the vehicle soc set by the user
+func (lp *Loadpoint) applyVehicleSoc() {
+	lp.Lock()
+	soc := lp.vehicleSocRequest
+	lp.vehicleSocRequest = nil
+	chargedEnergy := lp.chargedEnergy
+	lp.Unlock()
+
+	if soc == nil || lp.socEstimator == nil {
+		return
+	}
+
+	lp.socEstimator.SetSoc(*soc, chargedEnergy)
+	lp.socUpdated = time.Time{}
+}
+
+// persistVehicleSoc stores the estimated soc of a vehicle without soc api
+func (lp *Loadpoint) persistVehicleSoc() {
+	if lp.socEstimator != nil && lp.socEstimator.Estimated() {
+		settings.SetFloat(vehicleSocSetting(lp.vehicle), lp.vehicleSoc)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/coordinator"
 	"github.com/evcc-io/evcc/core/soc"
+	"github.com/evcc-io/evcc/core/wrapper"
 	"github.com/evcc-io/evcc/mock"
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
@@ -175,13 +176,15 @@ func TestDefaultVehicle(t *testing.T) {
 	off := api.ModeOff
 	zero := 0
 	hundred := 100
+	var noPrecondition time.Duration
 	onDisconnect := api.ActionConfig{
-		Mode:       &off,
-		MinCurrent: &lp.MinCurrent,
-		MaxCurrent: &lp.MaxCurrent,
-		MinSoc:     &zero,
-		TargetSoc:  &hundred,
-		Priority:   &zero,
+		Mode:         &off,
+		MinCurrent:   &lp.MinCurrent,
+		MaxCurrent:   &lp.MaxCurrent,
+		MinSoc:       &zero,
+		TargetSoc:    &hundred,
+		Priority:     &zero,
+		Precondition: &noPrecondition,
 	}
 
 	lp.collectDefaults()
@@ -381,3 +384,217 @@ func TestReconnectVehicle(t *testing.T) {
 		})
 	}
 }
+
+type socLimitVehicle struct {
+	*mock.MockVehicle
+	limits []int64
+	err    error
+}
+
+func (v *socLimitVehicle) SetTargetSoc(soc int64) error {
+	v.limits = append(v.limits, soc)
+	return v.err
+}
+
+func TestSyncVehicleSocLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	vehicle := &socLimitVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	clck := clock.NewMock()
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		clock:   clck,
+		vehicle: vehicle,
+		status:  api.StatusA,
+	}
+
+	// not connected
+	lp.syncVehicleSocLimit()
+	assert.Empty(t, vehicle.limits)
+
+	// no target defaults to full charge
+	lp.status = api.StatusB
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100}, vehicle.limits)
+
+	// unchanged target is not sent again
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100}, vehicle.limits)
+
+	lp.Soc.target = 80
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100, 80}, vehicle.limits)
+
+	// cached vehicle data still reports the previous limit
+	for i := 0; i < 3; i++ {
+		lp.vehicleSocLimitReported(60)
+		lp.syncVehicleSocLimit()
+	}
+	assert.Equal(t, []int64{100, 80}, vehicle.limits)
+
+	// limit lowered on vehicle side is re-synced once
+	lp.vehicleSocLimitReported(50)
+	lp.syncVehicleSocLimit()
+	lp.vehicleSocLimitReported(50)
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100, 80, 80}, vehicle.limits)
+
+	// failed limit is retried after delay
+	lp.Soc.target = 70
+	vehicle.err = errors.New("foo")
+	lp.syncVehicleSocLimit()
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100, 80, 80, 70}, vehicle.limits)
+
+	vehicle.err = nil
+	clck.Add(vehicleRetryDelay)
+	lp.syncVehicleSocLimit()
+	assert.Equal(t, []int64{100, 80, 80, 70, 70}, vehicle.limits)
+}
+
+type climateVehicle struct {
+	*mock.MockVehicle
+	climatize []bool
+	err       error
+}
+
+func (v *climateVehicle) Climatize(enable bool) error {
+	v.climatize = append(v.climatize, enable)
+	return v.err
+}
+
+func TestPreconditionVehicle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	vehicle := &climateVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		clock:         clck,
+		vehicle:       vehicle,
+		status:        api.StatusB,
+		Mode:          api.ModePV,
+		GuardDuration: 5 * time.Minute,
+		Precondition:  30 * time.Minute,
+		guardUpdated:  clck.Now(),
+	}
+
+	departure := clck.Now().Add(time.Hour)
+	lp.targetTime = departure
+
+	// too early
+	lp.preconditionVehicle()
+	assert.Empty(t, vehicle.climatize)
+
+	// charger disabled and guard not elapsed
+	clck.Add(31 * time.Minute)
+	lp.guardUpdated = clck.Now()
+	lp.preconditionVehicle()
+	assert.Empty(t, vehicle.climatize)
+
+	// guard elapsed, failed climatize is retried after delay
+	clck.Add(lp.GuardDuration)
+	vehicle.err = errors.New("foo")
+	lp.preconditionVehicle()
+	lp.preconditionVehicle()
+	assert.Equal(t, []bool{true}, vehicle.climatize)
+	assert.False(t, lp.preconditioning())
+
+	vehicle.err = nil
+	clck.Add(vehicleRetryDelay)
+	lp.preconditionVehicle()
+	assert.Equal(t, []bool{true, true}, vehicle.climatize)
+	assert.True(t, lp.vehicleClimateActive())
+
+	// started once per target time
+	lp.preconditionVehicle()
+	assert.Equal(t, []bool{true, true}, vehicle.climatize)
+
+	// target time removed
+	lp.targetTime = time.Time{}
+	lp.preconditionVehicle()
+	assert.Equal(t, []bool{true, true, false}, vehicle.climatize)
+	assert.False(t, lp.preconditioning())
+}
+
+type offlineVehicle struct {
+	*mock.MockVehicle
+}
+
+func (v *offlineVehicle) Features() []api.Feature {
+	return []api.Feature{api.Offline}
+}
+
+func TestSetVehicleSocOffline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := &offlineVehicle{mock.NewMockVehicle(ctrl)}
+	vehicle.EXPECT().Capacity().Return(float64(50)).AnyTimes()
+
+	log := util.NewLogger("foo")
+	lp := &Loadpoint{
+		log:           log,
+		clock:         clock.NewMock(),
+		vehicle:       vehicle,
+		socEstimator:  soc.NewEstimator(log, charger, vehicle, false),
+		chargedEnergy: 1000,
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- lp.SetVehicleSoc(50)
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		assert.FailNow(t, "deadlock setting vehicle soc")
+	}
+
+	// applied on update loop
+	lp.applyVehicleSoc()
+	assert.Nil(t, lp.vehicleSocRequest)
+
+	soc, err := lp.socEstimator.Soc(lp.chargedEnergy)
+	assert.NoError(t, err)
+	assert.Equal(t, 50.0, soc)
+}
+
+func TestChargerVehicle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	charger := &struct {
+		*mock.MockCharger
+		*mock.MockBattery
+	}{
+		mock.NewMockCharger(ctrl),
+		mock.NewMockBattery(ctrl),
+	}
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.vehicleIdentifier = "abc"
+
+	// charger has no vehicle data yet
+	charger.MockBattery.EXPECT().Soc().Return(0.0, api.ErrNotAvailable)
+	lp.identifyChargerVehicle()
+	assert.Nil(t, lp.vehicle)
+
+	charger.MockBattery.EXPECT().Soc().Return(42.0, nil)
+	lp.identifyChargerVehicle()
+
+	if assert.IsType(t, new(wrapper.ChargerVehicle), lp.vehicle) {
+		assert.Equal(t, "Vehicle abc", lp.vehicle.Title())
+		assert.Equal(t, []string{"abc"}, lp.vehicle.Identifiers())
+	}
+
+	// removed vehicle is not re-created during same connection
+	lp.setActiveVehicle(nil)
+	lp.identifyChargerVehicle()
+	assert.Nil(t, lp.vehicle)
+}
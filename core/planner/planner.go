@@ -26,6 +26,11 @@ func New(log *util.Logger, tariff api.Tariff) *Planner {
 	}
 }
 
+// SetClock sets the planner's clock
+func (t *Planner) SetClock(clock clock.Clock) {
+	t.clock = clock
+}
+
 // plan creates a lowest-cost plan or required duration.
 // It MUST already established that
 // - rates are sorted in ascending order by cost and descending order by start time (prefer late slots)
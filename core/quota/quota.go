@@ -0,0 +1,249 @@
+package quota
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+// Instance is the vehicle api quota scheduler shared by all loadpoints
+var Instance *Scheduler
+
+// Limit is the number of vehicle api requests allowed per interval and account
+type Limit struct {
+	Requests int
+	Interval time.Duration
+}
+
+// Defaults are the known brand api limits per account
+var Defaults = map[string]Limit{
+	// VAG
+	"vw":          {Requests: 60, Interval: time.Hour},
+	"id":          {Requests: 60, Interval: time.Hour},
+	"vw-id":       {Requests: 60, Interval: time.Hour},
+	"audi":        {Requests: 60, Interval: time.Hour},
+	"audi-etron":  {Requests: 60, Interval: time.Hour},
+	"skoda":       {Requests: 60, Interval: time.Hour},
+	"enyaq":       {Requests: 60, Interval: time.Hour},
+	"skoda-enyaq": {Requests: 60, Interval: time.Hour},
+	"seat":        {Requests: 60, Interval: time.Hour},
+	"cupra":       {Requests: 60, Interval: time.Hour},
+	"seat-cupra":  {Requests: 60, Interval: time.Hour},
+	// Hyundai/Kia
+	"hyundai": {Requests: 200, Interval: 24 * time.Hour},
+	"kia":     {Requests: 200, Interval: 24 * time.Hour},
+	// BMW
+	"bmw":  {Requests: 100, Interval: 24 * time.Hour},
+	"mini": {Requests: 100, Interval: 24 * time.Hour},
+}
+
+const (
+	reserve    = 4                // 1/reserve of the quota is kept for charging vehicles
+	maxBackoff = 2 * time.Hour    // maximum backoff after rate limiting
+	minBackoff = 30 * time.Second // minimum backoff after rate limiting
+)
+
+// account tracks the requests of all vehicles sharing a brand account
+type account struct {
+	name     string
+	limit    Limit
+	vehicles int
+	requests []time.Time // request timestamps within the current interval
+	retry    time.Time   // no requests before
+	failures int
+}
+
+// expire removes requests outside of the sliding window
+func (a *account) expire(now time.Time) {
+	var i int
+	for i < len(a.requests) && now.Sub(a.requests[i]) >= a.limit.Interval {
+		i++
+	}
+	a.requests = a.requests[i:]
+}
+
+// gap is the minimum distance between requests to spread the quota evenly
+func (a *account) gap() time.Duration {
+	return a.limit.Interval / time.Duration(a.limit.Requests)
+}
+
+// vehicle tracks the api cache of a single vehicle
+type vehicle struct {
+	account *account
+	cache   time.Duration // vehicle api cache duration
+	fetched time.Time     // last api request, reads within cache duration are served from cache
+}
+
+// cached returns true if a read at given time is served from the vehicle's cache
+func (v *vehicle) cached(now time.Time) bool {
+	return !v.fetched.IsZero() && now.Sub(v.fetched) < v.cache
+}
+
+// Scheduler distributes vehicle api requests within the brand's quota
+type Scheduler struct {
+	mu       sync.Mutex
+	log      *util.Logger
+	clock    clock.Clock
+	limits   map[string]Limit
+	accounts map[string]*account
+	vehicles map[api.Vehicle]*vehicle
+}
+
+// New creates a quota scheduler. Limits are merged with the defaults.
+func New(limits map[string]Limit) *Scheduler {
+	s := &Scheduler{
+		log:      util.NewLogger("quota"),
+		clock:    clock.New(),
+		limits:   make(map[string]Limit),
+		accounts: make(map[string]*account),
+		vehicles: make(map[api.Vehicle]*vehicle),
+	}
+
+	provider.OnResetCached(s.resetCached)
+
+	for k, v := range Defaults {
+		s.limits[k] = v
+	}
+	for k, v := range limits {
+		s.limits[strings.ToLower(k)] = v
+	}
+
+	return s
+}
+
+// Register assigns the vehicle to its brand account. Vehicles of brands without known limit are not scheduled.
+// Reads within the vehicle's api cache duration are served from cache and not accounted.
+func (s *Scheduler) Register(v api.Vehicle, brand, user string, cache time.Duration) {
+	limit, ok := s.limits[strings.ToLower(brand)]
+	if !ok || limit.Requests <= 0 || limit.Interval <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.ToLower(brand) + ":" + strings.ToLower(user)
+
+	a, ok := s.accounts[key]
+	if !ok {
+		a = &account{name: brand, limit: limit}
+		s.accounts[key] = a
+	}
+
+	a.vehicles++
+	s.vehicles[v] = &vehicle{account: a, cache: cache}
+}
+
+// resetCached forces the next read of all vehicles to be accounted once provider caches are reset
+func (s *Scheduler) resetCached() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range s.vehicles {
+		v.fetched = time.Time{}
+	}
+}
+
+// Allow returns true if the vehicle api may be polled now.
+// Charging vehicles may use the full quota, other vehicles keep a reserve and are spaced further apart.
+func (s *Scheduler) Allow(v api.Vehicle, charging bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vv, ok := s.vehicles[v]
+	if !ok {
+		return true
+	}
+
+	now := s.clock.Now()
+	if vv.cached(now) {
+		return true
+	}
+
+	a := vv.account
+	a.expire(now)
+
+	if now.Before(a.retry) {
+		return false
+	}
+
+	remaining := a.limit.Requests - len(a.requests)
+	if remaining <= 0 {
+		return false
+	}
+
+	gap := a.gap()
+	if !charging {
+		if remaining <= a.limit.Requests/reserve {
+			return false
+		}
+
+		gap *= time.Duration(a.vehicles)
+	}
+
+	return len(a.requests) == 0 || now.Sub(a.requests[len(a.requests)-1]) >= gap
+}
+
+// Done records a vehicle api request and backs off if the api asked to retry or is rate limited.
+// Successful reads served from the vehicle's cache are not recorded.
+func (s *Scheduler) Done(v api.Vehicle, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vv, ok := s.vehicles[v]
+	if !ok {
+		return
+	}
+
+	now := s.clock.Now()
+	if err == nil && vv.cached(now) {
+		return
+	}
+
+	a := vv.account
+	a.requests = append(a.requests, now)
+
+	var se request.StatusError
+	if errors.Is(err, api.ErrMustRetry) || errors.As(err, &se) && se.HasStatus(http.StatusTooManyRequests) {
+		a.failures++
+
+		backoff := minBackoff << (a.failures - 1)
+		if backoff > maxBackoff || backoff <= 0 {
+			backoff = maxBackoff
+		}
+
+		a.retry = now.Add(backoff)
+		s.log.WARN.Printf("%s: backing off for %v: %v", a.name, backoff, err)
+
+		return
+	}
+
+	if err == nil {
+		a.failures = 0
+		vv.fetched = now
+	}
+}
+
+// Remaining returns the remaining requests of the vehicle's account within the current interval
+func (s *Scheduler) Remaining(v api.Vehicle) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vv, ok := s.vehicles[v]
+	if !ok {
+		return 0, false
+	}
+
+	a := vv.account
+	a.expire(s.clock.Now())
+
+	return a.limit.Requests - len(a.requests), true
+}
@@ -0,0 +1,117 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	s := New(map[string]Limit{
+		"test": {Requests: 8, Interval: 8 * time.Minute},
+	})
+	s.clock = clck
+
+	v1 := mock.NewMockVehicle(ctrl)
+	v2 := mock.NewMockVehicle(ctrl)
+	other := mock.NewMockVehicle(ctrl)
+
+	s.Register(v1, "test", "user", 0)
+	s.Register(v2, "Test", "USER", 0)
+	s.Register(other, "unknown", "user", 0)
+
+	// unknown brand not scheduled
+	assert.True(t, s.Allow(other, false))
+	_, ok := s.Remaining(other)
+	assert.False(t, ok)
+
+	assert.True(t, s.Allow(v1, false))
+	s.Done(v1, nil)
+
+	// shared account spreads requests
+	assert.False(t, s.Allow(v2, false))
+	assert.False(t, s.Allow(v2, true))
+
+	clck.Add(time.Minute)
+	assert.True(t, s.Allow(v2, true))
+	assert.False(t, s.Allow(v2, false))
+
+	// consume quota down to reserve
+	for i := 0; i < 5; i++ {
+		clck.Add(time.Minute)
+		s.Done(v2, nil)
+	}
+
+	remaining, ok := s.Remaining(v1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, remaining)
+
+	// reserve kept for charging vehicles
+	clck.Add(time.Minute)
+	assert.False(t, s.Allow(v1, false))
+	assert.True(t, s.Allow(v1, true))
+
+	// requests expire
+	clck.Add(2 * time.Minute)
+	remaining, _ = s.Remaining(v1)
+	assert.Equal(t, 3, remaining)
+	assert.True(t, s.Allow(v1, false))
+
+	// back off after rate limiting
+	s.Done(v1, api.ErrMustRetry)
+	clck.Add(time.Minute)
+	assert.True(t, s.Allow(v1, true))
+
+	s.Done(v1, api.ErrMustRetry)
+	clck.Add(30 * time.Second)
+	assert.False(t, s.Allow(v1, true))
+
+	clck.Add(30 * time.Second)
+	assert.True(t, s.Allow(v1, true))
+}
+
+func TestSchedulerCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	s := New(map[string]Limit{
+		"test": {Requests: 8, Interval: 8 * time.Minute},
+	})
+	s.clock = clck
+
+	v := mock.NewMockVehicle(ctrl)
+	s.Register(v, "test", "user", 5*time.Minute)
+
+	s.Done(v, nil)
+
+	// reads served from cache are not accounted
+	for i := 0; i < 4; i++ {
+		clck.Add(time.Minute)
+		assert.True(t, s.Allow(v, false))
+		s.Done(v, nil)
+	}
+
+	remaining, _ := s.Remaining(v)
+	assert.Equal(t, 7, remaining)
+
+	// cache expired
+	clck.Add(time.Minute)
+	s.Done(v, nil)
+	remaining, _ = s.Remaining(v)
+	assert.Equal(t, 6, remaining)
+
+	// cache reset
+	provider.ResetCached()
+	s.Done(v, nil)
+	remaining, _ = s.Remaining(v)
+	assert.Equal(t, 5, remaining)
+}
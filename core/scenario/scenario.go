@@ -0,0 +1,599 @@
+package scenario
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Scenario is a declarative site simulation. Running it drives the real site and
+// loadpoint control logic against simulated devices in virtual time.
+type Scenario struct {
+	Start    time.Time
+	Duration time.Duration
+	Interval time.Duration // control interval, defaults to 30s
+
+	Site    map[string]interface{} // site configuration, meters are added automatically
+	PV      *simulator.Generator
+	Home    *simulator.Load
+	Battery *simulator.Battery
+	Tariffs tariff.Tariffs
+
+	Loadpoints []Loadpoint
+	Events     []Event
+}
+
+// Loadpoint is a loadpoint with simulated charger and vehicle
+type Loadpoint struct {
+	Config  map[string]interface{} // loadpoint configuration, charger and vehicle are added automatically
+	Phases  int                    // charger phases
+	Delay   time.Duration          // charger ramp delay
+	Vehicle simulator.VehicleConfig
+}
+
+// Event is an action executed at an offset from the scenario start
+type Event struct {
+	At        time.Duration
+	Loadpoint int
+	Action    func(lp loadpoint.API, vehicle *simulator.Vehicle) error
+}
+
+// Arrive connects the loadpoint's vehicle with the given soc
+func Arrive(at time.Duration, lp int, soc float64) Event {
+	return Event{At: at, Loadpoint: lp, Action: func(_ loadpoint.API, v *simulator.Vehicle) error {
+		v.SetSoc(soc)
+		v.SetConnected(true)
+		return nil
+	}}
+}
+
+// Depart disconnects the loadpoint's vehicle
+func Depart(at time.Duration, lp int) Event {
+	return Event{At: at, Loadpoint: lp, Action: func(_ loadpoint.API, v *simulator.Vehicle) error {
+		v.SetConnected(false)
+		return nil
+	}}
+}
+
+// Do executes a user action on the loadpoint
+func Do(at time.Duration, lp int, fun func(lp loadpoint.API) error) Event {
+	return Event{At: at, Loadpoint: lp, Action: func(lp loadpoint.API, _ *simulator.Vehicle) error {
+		return fun(lp)
+	}}
+}
+
+// Command is a recorded charger command
+type Command struct {
+	Time      time.Time
+	Loadpoint int
+	Command   string // enable, current or phases
+	Value     float64
+}
+
+// Value is a recorded published value
+type Value struct {
+	Time time.Time
+	util.Param
+}
+
+// Push is a recorded push event
+type Push struct {
+	Time time.Time
+	push.Event
+}
+
+// LoadpointResult is the outcome of a single loadpoint
+type LoadpointResult struct {
+	ChargedEnergy float64 // kWh
+	Soc           float64 // final vehicle soc
+}
+
+// Result is the recorded outcome of a scenario
+type Result struct {
+	Commands   []Command
+	Values     []Value
+	Events     []Push
+	Loadpoints []LoadpointResult
+
+	GridImport, GridExport, PVEnergy float64 // kWh
+	PVCurtailed                      float64 // kWh lost to pv curtailment
+	Cost                             float64 // grid import cost minus feed-in revenue
+	Uncovered                        bool    // tariffs did not cover the entire scenario
+}
+
+// Value returns the last published value for the loadpoint or the site if lp is nil
+func (r *Result) Value(lp *int, key string) (interface{}, bool) {
+	for i := len(r.Values) - 1; i >= 0; i-- {
+		if v := r.Values[i]; v.Key == key && sameLoadpoint(v.Loadpoint, lp) {
+			return v.Val, true
+		}
+	}
+	return nil, false
+}
+
+// Event returns the time of the loadpoint's first push event of the given type
+func (r *Result) Event(lp int, event string) (time.Time, bool) {
+	for _, ev := range r.Events {
+		if ev.Event.Event == event && sameLoadpoint(ev.Loadpoint, &lp) {
+			return ev.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Cycles returns the number of times the loadpoint's charger was enabled
+func (r *Result) Cycles(lp int) int {
+	var res int
+	for _, c := range r.Commands {
+		if c.Loadpoint == lp && c.Command == "enable" && c.Value == 1 {
+			res++
+		}
+	}
+	return res
+}
+
+// SelfConsumption returns the share of pv energy consumed on site
+func (r *Result) SelfConsumption() float64 {
+	if r.PVEnergy <= 0 {
+		return 0
+	}
+	return math.Max(0, 1-r.GridExport/r.PVEnergy)
+}
+
+func sameLoadpoint(a, b *int) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+// scenarioRun is a scenario's runtime state
+type scenarioRun struct {
+	log      *util.Logger
+	clock    *scenarioClock
+	model    *simulator.Model
+	site     *core.Site
+	chargers []*scenarioCharger
+	vehicles []*simulator.Vehicle
+
+	uiChan  chan util.Param
+	lpChans []scenarioChans
+
+	res Result
+}
+
+type scenarioChans struct {
+	ui   chan util.Param
+	push chan push.Event
+}
+
+// scenarioBuffer must hold all values published during a single update
+const scenarioBuffer = 1024
+
+// Run executes the scenario
+func (s Scenario) Run() (*Result, error) {
+	if s.Interval == 0 {
+		s.Interval = 30 * time.Second
+	}
+
+	r, err := s.setup()
+	if err != nil {
+		return nil, err
+	}
+
+	events := append([]Event(nil), s.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].At < events[j].At
+	})
+
+	end := s.Start.Add(s.Duration)
+	loadpoints := r.site.Loadpoints()
+
+	for i := 0; r.clock.Now().Before(end); i++ {
+		for len(events) > 0 && !r.clock.Now().Before(s.Start.Add(events[0].At)) {
+			ev := events[0]
+			events = events[1:]
+
+			if ev.Loadpoint < 0 || ev.Loadpoint >= len(loadpoints) {
+				return nil, fmt.Errorf("event at %v: invalid loadpoint %d", ev.At, ev.Loadpoint)
+			}
+
+			if err := ev.Action(loadpoints[ev.Loadpoint], r.vehicles[ev.Loadpoint]); err != nil {
+				return nil, fmt.Errorf("event at %v: %w", ev.At, err)
+			}
+		}
+
+		if len(loadpoints) > 0 {
+			r.site.Step(i % len(loadpoints))
+		}
+		r.drain()
+
+		r.account(s.Tariffs, s.Interval)
+	}
+
+	for id, c := range r.chargers {
+		energy, _ := c.TotalEnergy()
+		soc, _ := r.vehicles[id].Soc()
+
+		r.res.Loadpoints = append(r.res.Loadpoints, LoadpointResult{
+			ChargedEnergy: energy,
+			Soc:           soc,
+		})
+	}
+
+	r.res.PVEnergy, _ = r.model.PVEnergy()
+	r.res.PVCurtailed, _ = r.model.PVCurtailedEnergy()
+
+	return &r.res, nil
+}
+
+// setup creates the simulated devices, loadpoints and site
+func (s Scenario) setup() (*scenarioRun, error) {
+	clck := newScenarioClock(s.Start)
+
+	model := simulator.New(clck)
+	model.Seed(1)
+
+	if s.Home != nil {
+		model.SetLoad(s.Home)
+	}
+
+	cp := &scenarioConfig{
+		meters:   map[string]api.Meter{"grid": scenarioMeter(model.GridPower)},
+		chargers: make(map[string]api.Charger),
+		vehicles: make(map[string]api.Vehicle),
+	}
+
+	meters := map[string]interface{}{"grid": "grid"}
+
+	if s.PV != nil {
+		model.SetGenerator(s.PV)
+		cp.meters["pv"] = &scenarioPV{model}
+		meters["pv"] = []string{"pv"}
+	}
+
+	if s.Battery != nil {
+		model.SetBattery(s.Battery)
+		cp.meters["battery"] = &scenarioBattery{model}
+		meters["battery"] = []string{"battery"}
+	}
+
+	r := &scenarioRun{
+		log:    util.NewLogger("scenario"),
+		clock:  clck,
+		model:  model,
+		uiChan: make(chan util.Param, scenarioBuffer),
+	}
+
+	var (
+		loadpoints []*core.Loadpoint
+		vehicles   []api.Vehicle
+	)
+
+	for id, slp := range s.Loadpoints {
+		ref := fmt.Sprintf("lp%d", id+1)
+
+		v := model.AddVehicle(ref, slp.Vehicle)
+		c := &scenarioCharger{Charger: model.AddCharger(ref, slp.Phases, slp.Delay), lp: id, run: r}
+
+		vehicle := &scenarioVehicle{Vehicle: v, title: ref}
+		cp.chargers[ref] = c
+		cp.vehicles[ref] = vehicle
+
+		other := map[string]interface{}{"title": ref}
+		for k, v := range slp.Config {
+			other[k] = v
+		}
+		other["charger"] = ref
+		other["vehicle"] = ref
+
+		lp, err := core.NewLoadpointFromConfig(util.NewLogger("lp-"+strconv.Itoa(id+1)), cp, other)
+		if err != nil {
+			return nil, fmt.Errorf("loadpoint %d: %w", id+1, err)
+		}
+
+		loadpoints = append(loadpoints, lp)
+		vehicles = append(vehicles, vehicle)
+		r.chargers = append(r.chargers, c)
+		r.vehicles = append(r.vehicles, v)
+	}
+
+	other := map[string]interface{}{"title": "scenario"}
+	for k, v := range s.Site {
+		other[k] = v
+	}
+	other["meters"] = meters
+
+	// tariffs with mockable time follow the virtual clock
+	for _, t := range []api.Tariff{s.Tariffs.Grid, s.Tariffs.FeedIn, s.Tariffs.Planner} {
+		if t, ok := t.(interface{ SetClock(clock.Clock) }); ok {
+			t.SetClock(clck)
+		}
+	}
+
+	site, err := core.NewSiteFromConfig(util.NewLogger("site"), cp, other, loadpoints, vehicles, s.Tariffs)
+	if err != nil {
+		return nil, err
+	}
+
+	site.Health = core.NewHealth(time.Minute + s.Interval)
+	r.site = site
+
+	// values are timestamped in virtual time
+	var (
+		uiChans   []chan<- util.Param
+		pushChans []chan<- push.Event
+	)
+
+	for range loadpoints {
+		ch := scenarioChans{
+			ui:   make(chan util.Param, scenarioBuffer),
+			push: make(chan push.Event, scenarioBuffer),
+		}
+		r.lpChans = append(r.lpChans, ch)
+
+		uiChans = append(uiChans, ch.ui)
+		pushChans = append(pushChans, ch.push)
+	}
+
+	site.Simulate(clck, r.uiChan, uiChans, pushChans)
+
+	r.drain()
+
+	return r, nil
+}
+
+// drain records all values and events published since the last call
+func (r *scenarioRun) drain() {
+	now := r.clock.Now()
+
+SITE:
+	for {
+		select {
+		case p := <-r.uiChan:
+			r.res.Values = append(r.res.Values, Value{Time: now, Param: p})
+		default:
+			break SITE
+		}
+	}
+
+	for id, ch := range r.lpChans {
+		id := id
+
+	LP:
+		for {
+			select {
+			case p := <-ch.ui:
+				p.Loadpoint = &id
+				r.res.Values = append(r.res.Values, Value{Time: now, Param: p})
+			case ev := <-ch.push:
+				ev.Loadpoint = &id
+				r.res.Events = append(r.res.Events, Push{Time: now, Event: ev})
+			default:
+				break LP
+			}
+		}
+	}
+}
+
+// account advances the clock and integrates grid energy and cost
+func (r *scenarioRun) account(tariffs tariff.Tariffs, d time.Duration) {
+	imported, _ := r.model.GridEnergy()
+	exported, _ := r.model.GridExportEnergy()
+
+	price := r.price(tariffs.Grid)
+	feedin := r.price(tariffs.FeedIn)
+
+	r.clock.Add(d)
+
+	r.res.GridImport, _ = r.model.GridEnergy()
+	r.res.GridExport, _ = r.model.GridExportEnergy()
+
+	r.res.Cost += (r.res.GridImport-imported)*price - (r.res.GridExport-exported)*feedin
+}
+
+// price returns the tariff's current price. Tariffs not covering the virtual time are priced zero.
+func (r *scenarioRun) price(t api.Tariff) float64 {
+	if t == nil {
+		return 0
+	}
+
+	rates, err := t.Rates()
+	if err != nil {
+		return 0
+	}
+
+	rate, err := rates.Current(r.clock.Now())
+	if err != nil {
+		if !r.res.Uncovered {
+			r.log.WARN.Printf("tariff: no rate at %v", r.clock.Now())
+		}
+		r.res.Uncovered = true
+	}
+
+	return rate.Price
+}
+
+// record adds a charger command
+func (r *scenarioRun) record(lp int, command string, value float64) {
+	r.res.Commands = append(r.res.Commands, Command{
+		Time:      r.clock.Now(),
+		Loadpoint: lp,
+		Command:   command,
+		Value:     value,
+	})
+}
+
+// scenarioClock is a virtual clock. Unlike clock.Mock it does not yield to timers when advancing,
+// which is prohibitively slow for simulating long periods. Timers and tickers never fire.
+type scenarioClock struct {
+	clock.Clock
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newScenarioClock(now time.Time) *scenarioClock {
+	return &scenarioClock{
+		Clock: clock.NewMock(),
+		now:   now,
+	}
+}
+
+func (c *scenarioClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *scenarioClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *scenarioClock) Until(t time.Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+// Add advances the clock
+func (c *scenarioClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// scenarioConfig provides the scenario's devices
+type scenarioConfig struct {
+	meters   map[string]api.Meter
+	chargers map[string]api.Charger
+	vehicles map[string]api.Vehicle
+}
+
+func (cp *scenarioConfig) Meter(name string) (api.Meter, error) {
+	if res, ok := cp.meters[name]; ok {
+		return res, nil
+	}
+	return nil, fmt.Errorf("meter not found: %s", name)
+}
+
+func (cp *scenarioConfig) Charger(name string) (api.Charger, error) {
+	if res, ok := cp.chargers[name]; ok {
+		return res, nil
+	}
+	return nil, fmt.Errorf("charger not found: %s", name)
+}
+
+func (cp *scenarioConfig) Vehicle(name string) (api.Vehicle, error) {
+	if res, ok := cp.vehicles[name]; ok {
+		return res, nil
+	}
+	return nil, fmt.Errorf("vehicle not found: %s", name)
+}
+
+// scenarioCharger records the commands sent to a simulated charger
+type scenarioCharger struct {
+	*simulator.Charger
+	lp  int
+	run *scenarioRun
+}
+
+func (c *scenarioCharger) Enable(enable bool) error {
+	var val float64
+	if enable {
+		val = 1
+	}
+	c.run.record(c.lp, "enable", val)
+	return c.Charger.Enable(enable)
+}
+
+func (c *scenarioCharger) MaxCurrent(current int64) error {
+	return c.MaxCurrentMillis(float64(current))
+}
+
+func (c *scenarioCharger) MaxCurrentMillis(current float64) error {
+	c.run.record(c.lp, "current", current)
+	return c.Charger.MaxCurrentMillis(current)
+}
+
+func (c *scenarioCharger) Phases1p3p(phases int) error {
+	c.run.record(c.lp, "phases", float64(phases))
+	return c.Charger.Phases1p3p(phases)
+}
+
+// scenarioVehicle adapts a simulated vehicle to the api.Vehicle interface
+type scenarioVehicle struct {
+	*simulator.Vehicle
+	title string
+}
+
+func (v *scenarioVehicle) Title() string {
+	return v.title
+}
+
+func (v *scenarioVehicle) SetTitle(title string) {
+	v.title = title
+}
+
+func (v *scenarioVehicle) Capacity() float64 {
+	return v.Config().Capacity
+}
+
+func (v *scenarioVehicle) Phases() int {
+	return v.Config().Phases
+}
+
+func (v *scenarioVehicle) Icon() string {
+	return "car"
+}
+
+func (v *scenarioVehicle) Identifiers() []string {
+	return nil
+}
+
+func (v *scenarioVehicle) OnIdentified() api.ActionConfig {
+	return api.ActionConfig{}
+}
+
+// scenarioMeter is a power meter reading from the simulation model
+type scenarioMeter func() (float64, error)
+
+func (m scenarioMeter) CurrentPower() (float64, error) {
+	return m()
+}
+
+// scenarioPV is the simulation model's curtailable pv meter
+type scenarioPV struct {
+	model *simulator.Model
+}
+
+func (m *scenarioPV) CurrentPower() (float64, error) {
+	return m.model.PVPower()
+}
+
+func (m *scenarioPV) Curtail(limit float64) error {
+	return m.model.Curtail(limit)
+}
+
+// scenarioBattery is the simulation model's battery meter
+type scenarioBattery struct {
+	model *simulator.Model
+}
+
+func (m *scenarioBattery) CurrentPower() (float64, error) {
+	return m.model.BatteryPower()
+}
+
+func (m *scenarioBattery) Soc() (float64, error) {
+	return m.model.BatterySoc()
+}
+
+func (m *scenarioBattery) Capacity() float64 {
+	return m.model.BatteryCapacity()
+}
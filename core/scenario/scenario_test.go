@@ -0,0 +1,197 @@
+package scenario
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/tariff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scenarioStart = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func TestScenarioPVSurplus(t *testing.T) {
+	s := Scenario{
+		Start:    scenarioStart.Add(8 * time.Hour),
+		Duration: 8 * time.Hour,
+		PV:       &simulator.Generator{Peak: 10000},
+		Home:     &simulator.Load{Profile: simulator.Profile{500}},
+		Loadpoints: []Loadpoint{
+			{
+				Config:  map[string]interface{}{"mode": "pv"},
+				Vehicle: simulator.VehicleConfig{Capacity: 50, Soc: 20},
+			},
+			{
+				Config:  map[string]interface{}{"mode": "pv"},
+				Vehicle: simulator.VehicleConfig{Capacity: 50, Soc: 50, Disconnected: true},
+			},
+		},
+		Events: []Event{
+			Arrive(2*time.Hour, 1, 50),
+			Depart(6*time.Hour, 1),
+		},
+	}
+
+	res, err := s.Run()
+	require.NoError(t, err)
+
+	// surplus charging without grid import
+	assert.Less(t, res.GridImport, 0.5)
+	assert.Greater(t, res.GridExport, 0.0)
+	assert.Greater(t, res.SelfConsumption(), 0.5)
+
+	assert.Equal(t, 100.0, res.Loadpoints[0].Soc)
+	assert.Greater(t, res.Loadpoints[0].ChargedEnergy, 30.0)
+	assert.Greater(t, res.Loadpoints[1].ChargedEnergy, 0.0)
+
+	// second vehicle arrived and departed
+	lp := 1
+	connect, ok := res.Event(lp, "connect")
+	assert.True(t, ok)
+	assert.WithinDuration(t, s.Start.Add(2*time.Hour), connect, time.Minute) // round-robin updates
+
+	connected, _ := res.Value(&lp, "connected")
+	assert.Equal(t, false, connected)
+
+	_, ok = res.Value(nil, "pvPower")
+	assert.True(t, ok)
+}
+
+func TestScenarioTargetCharging(t *testing.T) {
+	var rates api.Rates
+	for h := 0; h < 48; h++ {
+		price := 0.4
+		if h >= 26 && h < 30 {
+			price = 0.1
+		}
+		start := scenarioStart.Add(time.Duration(h) * time.Hour)
+		rates = append(rates, api.Rate{Start: start, End: start.Add(time.Hour), Price: price})
+	}
+
+	target := scenarioStart.Add(31 * time.Hour)
+
+	s := Scenario{
+		Start:    scenarioStart.Add(18 * time.Hour),
+		Duration: 14 * time.Hour,
+		Home:     &simulator.Load{Profile: simulator.Profile{0}},
+		Tariffs:  tariff.Tariffs{Grid: &scenarioTariff{rates: rates}},
+		Loadpoints: []Loadpoint{
+			{
+				Config:  map[string]interface{}{"mode": "pv"},
+				Vehicle: simulator.VehicleConfig{Capacity: 50, Disconnected: true},
+			},
+		},
+		Events: []Event{
+			Arrive(0, 0, 40),
+			Do(time.Minute, 0, func(lp loadpoint.API) error {
+				lp.SetTargetSoc(80)
+				return lp.SetTargetTime(target)
+			}),
+		},
+	}
+
+	res, err := s.Run()
+	require.NoError(t, err)
+
+	// target reached on time
+	reached, ok := res.Event(0, "reached")
+	assert.True(t, ok)
+	assert.True(t, reached.Before(target))
+	assert.GreaterOrEqual(t, res.Loadpoints[0].Soc, 80.0)
+
+	// charged during cheap hours only
+	for _, c := range res.Commands {
+		if c.Command == "enable" && c.Value == 1 {
+			assert.False(t, c.Time.Before(scenarioStart.Add(26*time.Hour)), c.Time)
+		}
+	}
+
+	assert.InDelta(t, res.GridImport*0.1, res.Cost, 0.05)
+}
+
+// scenarioTariff is a dynamic tariff with fixed rates
+type scenarioTariff struct {
+	rates api.Rates
+}
+
+func (t *scenarioTariff) Unit() string {
+	return "EUR"
+}
+
+func (t *scenarioTariff) Rates() (api.Rates, error) {
+	return append(api.Rates(nil), t.rates...), nil
+}
+
+func (t *scenarioTariff) IsDynamic() bool {
+	return true
+}
+
+func TestScenarioZeroExport(t *testing.T) {
+	s := Scenario{
+		Start:    scenarioStart.Add(10 * time.Hour),
+		Duration: 4 * time.Hour,
+		Site:     map[string]interface{}{"maxGridExport": 0},
+		PV:       &simulator.Generator{Peak: 10000},
+		Home:     &simulator.Load{Profile: simulator.Profile{500}},
+		Loadpoints: []Loadpoint{
+			{
+				Config:  map[string]interface{}{"mode": "pv"},
+				Vehicle: simulator.VehicleConfig{Capacity: 50, Soc: 20, Disconnected: true},
+			},
+		},
+		Events: []Event{
+			Arrive(2*time.Hour, 0, 20),
+		},
+	}
+
+	res, err := s.Run()
+	require.NoError(t, err)
+
+	// export limited by curtailment
+	assert.Less(t, res.GridExport, 0.2)
+	assert.Greater(t, res.PVCurtailed, 5.0)
+
+	// curtailed pv is made available to the arriving vehicle
+	assert.Greater(t, res.Loadpoints[0].ChargedEnergy, 10.0)
+	assert.Less(t, res.GridImport, 0.5)
+}
+
+func TestScenarioCurtailNegativePrice(t *testing.T) {
+	var rates api.Rates
+	for h := 0; h < 24; h++ {
+		price := 0.08
+		if h >= 12 && h < 14 {
+			price = -0.05
+		}
+		start := scenarioStart.Add(time.Duration(h) * time.Hour)
+		rates = append(rates, api.Rate{Start: start, End: start.Add(time.Hour), Price: price})
+	}
+
+	s := Scenario{
+		Start:    scenarioStart.Add(10 * time.Hour),
+		Duration: 2 * time.Hour,
+		Site:     map[string]interface{}{"curtailNegativePrice": true},
+		PV:       &simulator.Generator{Peak: 10000},
+		Home:     &simulator.Load{Profile: simulator.Profile{500}},
+		Tariffs:  tariff.Tariffs{FeedIn: &scenarioTariff{rates: rates}},
+		Loadpoints: []Loadpoint{
+			{Vehicle: simulator.VehicleConfig{Disconnected: true}},
+		},
+	}
+
+	res, err := s.Run()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, res.PVCurtailed)
+
+	// no export during negative prices
+	s.Start = scenarioStart.Add(12 * time.Hour)
+
+	res, err = s.Run()
+	require.NoError(t, err)
+	assert.Less(t, res.GridExport, 0.2)
+	assert.Greater(t, res.PVCurtailed, 10.0)
+}
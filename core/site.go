@@ -7,7 +7,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/avast/retry-go/v3"
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/core/coordinator"
@@ -32,7 +32,8 @@ type Updater interface {
 
 // meterMeasurement is used as slice element for publishing structured data
 type meterMeasurement struct {
-	Power float64 `json:"power"`
+	Power   float64 `json:"power"`
+	Latency float64 `json:"latency"` // ms
 }
 
 // batteryMeasurement is used as slice element for publishing structured data
@@ -40,6 +41,7 @@ type batteryMeasurement struct {
 	Power    float64 `json:"power"`
 	Soc      float64 `json:"soc"`
 	Capacity float64 `json:"capacity"`
+	Latency  float64 `json:"latency"` // ms
 }
 
 // Site is the main configuration container. A site can host multiple loadpoints.
@@ -50,7 +52,8 @@ type Site struct {
 	*Health
 
 	sync.Mutex
-	log *util.Logger
+	log   *util.Logger
+	clock clock.Clock // mockable time
 
 	// configuration
 	Title                             string       `mapstructure:"title"`         // UI title
@@ -61,12 +64,16 @@ type Site struct {
 	BufferSoc                         float64      `mapstructure:"bufferSoc"`                         // ignore battery above this Soc
 	MaxGridSupplyWhileBatteryCharging float64      `mapstructure:"maxGridSupplyWhileBatteryCharging"` // ignore battery charging if AC consumption is above this value
 	SmartCostLimit                    float64      `mapstructure:"smartCostLimit"`                    // always charge if cost is below this value
+	MaxGridExport                     *float64     `mapstructure:"maxGridExport"`                     // curtail pv to stay below this grid export power
+	CurtailNegativePrice              bool         `mapstructure:"curtailNegativePrice"`              // curtail pv export at negative feed-in prices
+	Event                             *EventConfig `mapstructure:"event"`                             // event-driven control
 
 	// meters
 	gridMeter     api.Meter   // Grid usage meter
 	pvMeters      []api.Meter // PV generation meters
 	batteryMeters []api.Meter // Battery charging meters
 	auxMeters     []api.Meter // Auxiliary meters
+	curtailers    []curtailer // Curtailable pv meters
 
 	tariffs     tariff.Tariffs           // Tariff
 	loadpoints  []*Loadpoint             // Loadpoints
@@ -75,14 +82,28 @@ type Site struct {
 	savings     *Savings                 // Savings
 
 	// cached state
-	gridPower    float64 // Grid power
-	pvPower      float64 // PV power
-	batteryPower float64 // Battery charge power
-	batterySoc   float64 // Battery soc
+	gridPower    float64   // Grid power
+	pvPower      float64   // PV power
+	pvPowers     []float64 // PV power per meter
+	batteryPower float64   // Battery charge power
+	batterySoc   float64   // Battery soc
+	auxPower     float64   // Auxiliary power
+
+	curtailment  curtailment             // pv curtailment state
+	curtailMu    sync.Mutex              // guards curtailment against release on shutdown
+	curtailStop  bool                    // curtailment released on shutdown
+	meterDevices map[string]*meterDevice // concurrently read meters
+	snapshot     time.Time               // start of last meter reading
 
 	publishCache map[string]any // store last published values to avoid unnecessary republishing
 }
 
+// EventConfig defines event-driven control triggered by push-based meter updates
+type EventConfig struct {
+	Deadband    float64       `mapstructure:"deadband"`    // grid power change triggering an update (W)
+	MinInterval time.Duration `mapstructure:"minInterval"` // minimum time between updates
+}
+
 // MetersConfig contains the loadpoint's meter configuration
 type MetersConfig struct {
 	GridMeterRef      string   `mapstructure:"grid"`      // Grid usage meter
@@ -109,6 +130,21 @@ func NewSiteFromConfig(
 
 	Voltage = site.Voltage
 	site.loadpoints = loadpoints
+
+	// event-driven control requires grid meter
+	if site.Event != nil {
+		if site.Meters.GridMeterRef == "" {
+			site.log.WARN.Println("event-driven control requires grid meter, using polling")
+			site.Event = nil
+		} else {
+			if site.Event.Deadband == 0 {
+				site.Event.Deadband = 50
+			}
+			if site.Event.MinInterval == 0 {
+				site.Event.MinInterval = 2 * time.Second
+			}
+		}
+	}
 	site.tariffs = tariffs
 	site.coordinator = coordinator.New(log, vehicles)
 	site.prioritizer = prioritizer.New()
@@ -154,6 +190,10 @@ func NewSiteFromConfig(
 			return nil, err
 		}
 		site.pvMeters = append(site.pvMeters, pv)
+
+		if c, ok := pv.(api.Curtailer); ok && site.curtailing() {
+			site.curtailers = append(site.curtailers, curtailer{Curtailer: c, id: len(site.pvMeters) - 1, limit: -1})
+		}
 	}
 
 	// multiple batteries
@@ -179,6 +219,22 @@ func NewSiteFromConfig(
 		return nil, errors.New("missing either grid or pv meter")
 	}
 
+	// grid export limit requires measuring export and curtailing pv
+	if site.curtailing() {
+		if site.gridMeter == nil {
+			return nil, errors.New("curtailment requires grid meter")
+		}
+		if len(site.curtailers) == 0 {
+			site.log.WARN.Println("curtailment: no pv meter supports curtailment")
+		}
+	}
+
+	// release limits left over from unclean shutdown
+	if len(site.curtailers) > 0 {
+		site.releaseCurtailment()
+		shutdown.Register(site.stopCurtailment)
+	}
+
 	return site, nil
 }
 
@@ -186,7 +242,9 @@ func NewSiteFromConfig(
 func NewSite() *Site {
 	lp := &Site{
 		log:          util.NewLogger("site"),
+		clock:        clock.New(),
 		publishCache: make(map[string]any),
+		curtailment:  curtailment{limit: -1},
 		Voltage:      230, // V
 	}
 
@@ -208,12 +266,18 @@ func meterCapabilities(name string, meter interface{}) string {
 	_, currents := meter.(api.PhaseCurrents)
 
 	name += ":"
-	return fmt.Sprintf("    %-10s power %s energy %s currents %s",
+	res := fmt.Sprintf("    %-10s power %s energy %s currents %s",
 		name,
 		presence[power],
 		presence[energy],
 		presence[currents],
 	)
+
+	if _, ok := meter.(api.Curtailer); ok {
+		res += " curtail " + presence[ok]
+	}
+
+	return res
 }
 
 // DumpConfig site configuration
@@ -320,57 +384,30 @@ func (site *Site) publishDelta(key string, val interface{}) {
 	site.publish(key, val)
 }
 
-// updateMeter updates and publishes single meter
-func (site *Site) updateMeter(meter api.Meter, power *float64) func() error {
-	return func() error {
-		value, err := meter.CurrentPower()
-		if err == nil {
-			*power = value // update value if no error
-		}
-
-		return err
-	}
-}
-
-// updateMeter updates and publishes single meter
+// updateMeters updates and publishes site meters
 func (site *Site) updateMeters() error {
-	retryMeter := func(name string, meter api.Meter, power *float64) error {
-		if meter == nil {
-			return nil
-		}
-
-		err := retry.Do(site.updateMeter(meter, power), retryOptions...)
-
-		if err == nil {
-			site.log.DEBUG.Printf("%s power: %.0fW", name, *power)
-			site.publish(name+"Power", *power)
-		} else {
-			err = fmt.Errorf("%s meter: %v", name, err)
-			site.log.ERROR.Println(err)
-		}
-
-		return err
-	}
+	readings := site.readMeters()
 
 	if len(site.pvMeters) > 0 {
 		site.pvPower = 0
+		site.pvPowers = make([]float64, len(site.pvMeters))
 		mm := make([]meterMeasurement, len(site.pvMeters))
 
-		for i, meter := range site.pvMeters {
-			var power float64
-			err := retry.Do(site.updateMeter(meter, &power), retryOptions...)
+		for i := range site.pvMeters {
+			r := readings[fmt.Sprintf("pv%d", i+1)]
+			power := r.power
 
-			mm[i] = meterMeasurement{Power: power}
+			mm[i] = meterMeasurement{Power: power, Latency: r.latencyMs()}
 
-			if err == nil {
+			if r.err == nil {
 				// ignore negative values which represent self-consumption
-				site.pvPower += math.Max(0, power)
+				site.pvPowers[i] = math.Max(0, power)
+				site.pvPower += site.pvPowers[i]
 				if power < -500 {
 					site.log.WARN.Printf("pv %d power: %.0fW is negative - check configuration if sign is correct", i+1, power)
 				}
 			} else {
-				err = fmt.Errorf("pv %d power: %v", i+1, err)
-				site.log.ERROR.Println(err)
+				site.log.ERROR.Printf("pv %d power: %v", i+1, r.err)
 			}
 		}
 
@@ -388,24 +425,23 @@ func (site *Site) updateMeters() error {
 		mm := make([]batteryMeasurement, len(site.batteryMeters))
 
 		for i, meter := range site.batteryMeters {
-			var power float64
+			r := readings[fmt.Sprintf("battery%d", i+1)]
+			power := r.power
 
 			// NOTE battery errors are logged but ignored as we don't consider them relevant
-			err := retry.Do(site.updateMeter(meter, &power), retryOptions...)
-
-			if err == nil {
+			if r.err == nil {
 				site.batteryPower += power
 				if len(site.batteryMeters) > 1 {
 					site.log.DEBUG.Printf("battery %d power: %.0fW", i+1, power)
 				}
 			} else {
-				site.log.ERROR.Printf("battery %d power: %v", i+1, err)
+				site.log.ERROR.Printf("battery %d power: %v", i+1, r.err)
 			}
 
 			var capacity float64
-			soc, err := meter.(api.Battery).Soc()
+			soc := r.soc
 
-			if err == nil {
+			if r.socErr == nil {
 				// weigh soc by capacity and accumulate total capacity
 				weighedSoc := soc
 				if m, ok := meter.(api.BatteryCapacity); ok {
@@ -419,13 +455,14 @@ func (site *Site) updateMeters() error {
 					site.log.DEBUG.Printf("battery %d soc: %.0f%%", i+1, soc)
 				}
 			} else {
-				site.log.ERROR.Printf("battery %d soc: %v", i+1, err)
+				site.log.ERROR.Printf("battery %d soc: %v", i+1, r.socErr)
 			}
 
 			mm[i] = batteryMeasurement{
 				Power:    power,
 				Soc:      soc,
 				Capacity: capacity,
+				Latency:  r.latencyMs(),
 			}
 		}
 
@@ -446,7 +483,45 @@ func (site *Site) updateMeters() error {
 		site.publish("battery", mm)
 	}
 
-	err := retryMeter("grid", site.gridMeter, &site.gridPower)
+	if len(site.auxMeters) > 0 {
+		site.auxPower = 0
+		mm := make([]meterMeasurement, len(site.auxMeters))
+
+		for i := range site.auxMeters {
+			r := readings[fmt.Sprintf("aux%d", i+1)]
+			mm[i].Latency = r.latencyMs()
+
+			if r.err == nil {
+				site.auxPower += r.power
+				mm[i].Power = r.power
+				site.log.DEBUG.Printf("aux power %d: %.0fW", i+1, r.power)
+			} else {
+				site.log.ERROR.Printf("aux meter %d: %v", i+1, r.err)
+			}
+		}
+
+		site.log.DEBUG.Printf("aux power: %.0fW", site.auxPower)
+		site.publish("auxPower", site.auxPower)
+
+		site.publish("aux", mm)
+	}
+
+	if site.gridMeter == nil {
+		return nil
+	}
+
+	r := readings["grid"]
+	err := r.err
+
+	if err == nil {
+		site.gridPower = r.power
+		site.log.DEBUG.Printf("grid power: %.0fW", site.gridPower)
+		site.publish("gridPower", site.gridPower)
+		site.publish("gridLatency", r.latencyMs())
+	} else {
+		err = fmt.Errorf("grid meter: %v", err)
+		site.log.ERROR.Println(err)
+	}
 
 	// powers
 	var p1, p2, p3 float64
@@ -534,26 +609,12 @@ func (site *Site) sitePower(totalChargePower, flexiblePower float64) (float64, b
 	sitePower := sitePower(site.log, site.MaxGridSupplyWhileBatteryCharging, site.gridPower, batteryPower, site.ResidualPower)
 
 	// deduct smart loads
-	if len(site.auxMeters) > 0 {
-		var auxPower float64
-		mm := make([]meterMeasurement, len(site.auxMeters))
-
-		for i, meter := range site.auxMeters {
-			if power, err := meter.CurrentPower(); err == nil {
-				auxPower += power
-				mm[i].Power = power
-				site.log.DEBUG.Printf("aux power %d: %.0fW", i+1, power)
-			} else {
-				site.log.ERROR.Printf("aux meter %d: %v", i+1, err)
-			}
-		}
-
-		sitePower -= auxPower
-
-		site.log.DEBUG.Printf("aux power: %.0fW", auxPower)
-		site.publish("auxPower", auxPower)
+	sitePower -= site.auxPower
 
-		site.publish("aux", mm)
+	// make curtailed pv available to loadpoints
+	if headroom := site.curtailedPower(); headroom > 0 {
+		site.log.DEBUG.Printf("curtailed pv available: %.0fW", headroom)
+		sitePower -= headroom
 	}
 
 	// handle priority
@@ -629,6 +690,8 @@ func (s *Site) publishTariffs() {
 func (site *Site) update(lp Updater) {
 	site.log.DEBUG.Println("----")
 
+	site.updateDevices()
+
 	// update all loadpoint's charge power
 	var totalChargePower float64
 	for _, lp := range site.loadpoints {
@@ -650,7 +713,7 @@ func (site *Site) update(lp Updater) {
 
 		var rate api.Rate
 		if err == nil {
-			rate, err = rates.Current(time.Now())
+			rate, err = rates.Current(site.clock.Now())
 		}
 
 		if err == nil {
@@ -664,6 +727,9 @@ func (site *Site) update(lp Updater) {
 	if sitePower, batteryBuffered, err := site.sitePower(totalChargePower, flexiblePower); err == nil {
 		lp.Update(sitePower, autoCharge, batteryBuffered)
 
+		// curtail remaining export exceeding the grid export limit
+		site.curtail()
+
 		// ignore negative pvPower values as that means it is not an energy source but consumption
 		homePower := site.gridPower + math.Max(0, site.pvPower) + site.batteryPower - totalChargePower
 		homePower = math.Max(homePower, 0)
@@ -675,6 +741,15 @@ func (site *Site) update(lp Updater) {
 	site.publishTariffs()
 	greenShare := site.greenShare()
 
+	// attribute session energy to solar and cost
+	price, err := site.effectivePrice(greenShare)
+	if err != nil {
+		price = 0
+	}
+	for _, lp := range site.loadpoints {
+		lp.accountSessionEnergy(greenShare, price)
+	}
+
 	// TODO: use energy instead of current power for better results
 	deltaCharged := site.savings.Update(site, greenShare, totalChargePower)
 	if telemetry.Enabled() && totalChargePower > standbyPower {
@@ -693,6 +768,9 @@ func (site *Site) prepare() {
 	site.publish("prioritySoc", site.PrioritySoc)
 	site.publish("residualPower", site.ResidualPower)
 	site.publish("smartCostLimit", site.SmartCostLimit)
+	if site.MaxGridExport != nil {
+		site.publish("maxGridExport", *site.MaxGridExport)
+	}
 	if tariff := site.GetTariff(PlannerTariff); tariff != nil {
 		site.publish("smartCostUnit", tariff.Unit())
 		site.publish("smartCostAvailable", tariff.IsDynamic())
@@ -749,17 +827,55 @@ func (site *Site) Run(stopC chan struct{}, interval time.Duration) {
 	loadpointChan := make(chan Updater)
 	go site.loopLoadpoints(loadpointChan)
 
-	ticker := time.NewTicker(interval)
-	site.update(<-loadpointChan) // start immediately
+	// push-based meter updates
+	var eventC <-chan struct{}
+	if site.Event != nil {
+		if eventC = util.Updated(); eventC == nil {
+			site.log.WARN.Println("event-driven control requires push-based grid meter, using polling")
+		}
+	}
+
+	site.run(stopC, interval, eventC, loadpointChan, site.update)
+}
+
+// run executes the update on each tick, loadpoint request and grid meter event.
+// Grid meter events trigger updates if grid power has changed beyond the deadband
+// and the minimum interval since the last update has elapsed.
+func (site *Site) run(stopC chan struct{}, interval time.Duration, eventC <-chan struct{}, next <-chan Updater, update func(Updater)) {
+	ticker := site.clock.Ticker(interval)
+	update(<-next) // start immediately
+	updated := site.clock.Now()
 
 	for {
 		select {
 		case <-ticker.C:
-			site.update(<-loadpointChan)
+			update(<-next)
+			updated = site.clock.Now()
 		case lp := <-site.lpUpdateChan:
-			site.update(lp)
+			update(lp)
+			updated = site.clock.Now()
+		case <-eventC:
+			if site.clock.Since(updated) < site.Event.MinInterval || !site.gridPowerChanged() {
+				continue
+			}
+
+			update(<-next)
+			updated = site.clock.Now()
+
+			// polling is the fallback if updates become stale
+			ticker.Reset(interval)
 		case <-stopC:
 			return
 		}
 	}
 }
+
+// gridPowerChanged checks if grid power has changed beyond the event deadband since the last update
+func (site *Site) gridPowerChanged() bool {
+	r := site.meterDevice("grid", site.gridMeter).read(meterTimeout, meterMaxAge)
+	if r.err != nil {
+		return false
+	}
+
+	return math.Abs(r.power-site.gridPower) >= site.Event.Deadband
+}
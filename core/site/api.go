@@ -5,6 +5,11 @@ import (
 	"github.com/evcc-io/evcc/core/loadpoint"
 )
 
+// Controller gives access to site
+type Controller interface {
+	SiteControl(API)
+}
+
 // API is the external site API
 type API interface {
 	Healthy() bool
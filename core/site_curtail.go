@@ -0,0 +1,180 @@
+package core
+
+import (
+	"math"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// curtailTolerance is the margin below the limit at which pv is considered unconstrained
+const curtailTolerance = 100 // W
+
+// curtailer is a pv meter capable of limiting its inverter output
+type curtailer struct {
+	api.Curtailer
+	id    int     // pv meter index
+	limit float64 // applied limit, negative if not curtailed
+}
+
+// curtailment is the pv curtailment controller state
+type curtailment struct {
+	limit     float64 // total limit of curtailable pv, negative if not curtailed
+	potential float64 // estimated curtailable pv power without curtailment
+}
+
+// active returns true if pv is curtailed
+func (c curtailment) active() bool {
+	return c.limit >= 0
+}
+
+// curtailing returns true if pv curtailment is configured
+func (site *Site) curtailing() bool {
+	return site.MaxGridExport != nil || site.CurtailNegativePrice
+}
+
+// exportLimit returns the current grid export limit and if export is limited
+func (site *Site) exportLimit() (float64, bool) {
+	limit, limited := math.Inf(1), false
+	if site.MaxGridExport != nil {
+		limit, limited = math.Max(0, *site.MaxGridExport), true
+	}
+
+	// no export at negative feed-in prices
+	if site.CurtailNegativePrice {
+		if tariff := site.GetTariff(FeedinTariff); tariff != nil {
+			rates, err := tariff.Rates()
+
+			var rate api.Rate
+			if err == nil {
+				rate, err = rates.Current(site.clock.Now())
+			}
+
+			if err == nil && rate.Price < 0 {
+				limit, limited = 0, true
+			}
+		}
+	}
+
+	return limit, limited
+}
+
+// curtailablePower returns the power of all curtailable pv meters
+func (site *Site) curtailablePower() float64 {
+	var res float64
+	for _, c := range site.curtailers {
+		if c.id < len(site.pvPowers) {
+			res += site.pvPowers[c.id]
+		}
+	}
+	return res
+}
+
+// curtailedPower returns the estimated pv power withheld by curtailment
+func (site *Site) curtailedPower() float64 {
+	if !site.curtailment.active() {
+		return 0
+	}
+	return math.Max(0, site.curtailment.potential-site.curtailablePower())
+}
+
+// curtail limits pv generation if grid export exceeds the export limit after
+// loadpoints and batteries have consumed the surplus, and releases the
+// curtailment once pv is no longer constrained
+func (site *Site) curtail() {
+	site.curtailMu.Lock()
+	defer site.curtailMu.Unlock()
+
+	if len(site.curtailers) == 0 || site.curtailStop {
+		return
+	}
+
+	limit, limited := site.exportLimit()
+	power := site.curtailablePower()
+	export := -site.gridPower
+
+	if !limited || site.curtailment.active() && power < site.curtailment.limit-curtailTolerance && export < limit {
+		if site.curtailment.active() {
+			site.log.DEBUG.Println("curtailment: released")
+			site.setCurtailment(-1)
+		}
+		return
+	}
+
+	if !site.curtailment.active() {
+		if export <= limit {
+			return
+		}
+
+		site.curtailment.potential = power
+	}
+
+	// adjust curtailable pv to compensate export deviating from the limit
+	pvLimit := math.Max(0, power-(export-limit))
+	site.curtailment.potential = math.Max(site.curtailment.potential, pvLimit)
+
+	site.log.DEBUG.Printf("curtailment: export %.0fW limit %.0fW pv limit %.0fW", export, limit, pvLimit)
+	site.setCurtailment(pvLimit)
+}
+
+// setCurtailment distributes the pv limit across curtailers proportional to their power.
+// Negative limits release the curtailment.
+func (site *Site) setCurtailment(limit float64) {
+	power := site.curtailablePower()
+
+	for i, c := range site.curtailers {
+		res := limit
+		if limit >= 0 {
+			// distribute evenly if curtailed to zero
+			share := 1 / float64(len(site.curtailers))
+			if power > 0 {
+				share = site.pvPowers[c.id] / power
+			}
+			res = limit * share
+		}
+
+		if err := c.Curtail(res); err != nil {
+			site.log.ERROR.Printf("pv %d curtail: %v", c.id+1, err)
+			continue
+		}
+
+		site.curtailers[i].limit = res
+	}
+
+	site.curtailment.limit = site.appliedCurtailment()
+	site.publish("pvCurtailed", site.curtailment.active())
+}
+
+// appliedCurtailment returns the total limit successfully applied to the curtailers,
+// negative if none is curtailed
+func (site *Site) appliedCurtailment() float64 {
+	res := -1.0
+	for _, c := range site.curtailers {
+		if c.limit >= 0 {
+			res = math.Max(res, 0) + c.limit
+		}
+	}
+	return res
+}
+
+// releaseCurtailment unconditionally releases all curtailers
+func (site *Site) releaseCurtailment() {
+	for i, c := range site.curtailers {
+		if err := c.Curtail(-1); err != nil {
+			site.log.ERROR.Printf("pv %d curtail: %v", c.id+1, err)
+			continue
+		}
+
+		site.curtailers[i].limit = -1
+	}
+
+	site.curtailment.limit = site.appliedCurtailment()
+}
+
+// stopCurtailment releases all curtailers on shutdown and prevents further curtailment
+func (site *Site) stopCurtailment() {
+	site.curtailMu.Lock()
+	defer site.curtailMu.Unlock()
+
+	site.curtailStop = true
+	site.releaseCurtailment()
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type curtailFunc func(float64) error
+
+func (f curtailFunc) Curtail(limit float64) error {
+	return f(limit)
+}
+
+func TestCurtail(t *testing.T) {
+	limits := make([]float64, 2)
+
+	site := &Site{
+		log:           util.NewLogger("foo"),
+		MaxGridExport: new(float64),
+		curtailment:   curtailment{limit: -1},
+	}
+
+	for i := range limits {
+		i := i
+		site.curtailers = append(site.curtailers, curtailer{id: i, limit: -1, Curtailer: curtailFunc(func(limit float64) error {
+			limits[i] = limit
+			return nil
+		})})
+	}
+
+	// export within limit
+	site.gridPower, site.pvPowers = 100, []float64{3000, 1000}
+	site.curtail()
+	assert.False(t, site.curtailment.active())
+
+	// curtail export proportionally
+	site.gridPower = -2000
+	site.curtail()
+	assert.True(t, site.curtailment.active())
+	assert.Equal(t, []float64{1500, 500}, limits)
+	assert.Equal(t, 0.0, site.curtailedPower())
+
+	// withheld pv is made available
+	site.gridPower, site.pvPowers = 0, []float64{1500, 500}
+	assert.Equal(t, 2000.0, site.curtailedPower())
+
+	// load appears, raise limit
+	site.gridPower = 1000
+	site.curtail()
+	assert.Equal(t, []float64{2250, 750}, limits)
+
+	// pv no longer constrained, release
+	site.gridPower, site.pvPowers = 500, []float64{1800, 600}
+	site.curtail()
+	assert.False(t, site.curtailment.active())
+	assert.Equal(t, []float64{-1, -1}, limits)
+}
+
+func TestCurtailStop(t *testing.T) {
+	var limit float64
+
+	site := &Site{
+		log:           util.NewLogger("foo"),
+		MaxGridExport: new(float64),
+		curtailment:   curtailment{limit: -1},
+		curtailers: []curtailer{{limit: -1, Curtailer: curtailFunc(func(l float64) error {
+			limit = l
+			return nil
+		})}},
+	}
+
+	site.gridPower, site.pvPowers = -2000, []float64{3000}
+	site.curtail()
+	assert.Equal(t, 1000.0, limit)
+
+	// shutdown releases and prevents further curtailment
+	site.stopCurtailment()
+	assert.Equal(t, -1.0, limit)
+
+	site.curtail()
+	assert.Equal(t, -1.0, limit)
+}
+
+func TestCurtailError(t *testing.T) {
+	var err error
+
+	site := &Site{
+		log:           util.NewLogger("foo"),
+		MaxGridExport: new(float64),
+		curtailment:   curtailment{limit: -1},
+		curtailers: []curtailer{{limit: -1, Curtailer: curtailFunc(func(float64) error {
+			return err
+		})}},
+	}
+
+	// failed curtailment is not applied
+	err = errors.New("foo")
+	site.gridPower, site.pvPowers = -2000, []float64{3000}
+	site.curtail()
+	assert.False(t, site.curtailment.active())
+	assert.Equal(t, 0.0, site.curtailedPower())
+
+	// retried on next cycle
+	err = nil
+	site.curtail()
+	assert.True(t, site.curtailment.active())
+	assert.Equal(t, 1000.0, site.curtailment.limit)
+
+	// failed release keeps curtailment
+	err = errors.New("foo")
+	site.MaxGridExport = nil
+	site.curtail()
+	assert.True(t, site.curtailment.active())
+}
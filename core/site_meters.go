@@ -0,0 +1,232 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v3"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+var (
+	meterTimeout = 10 * time.Second // deadline for reading a single meter
+	meterMaxAge  = time.Minute      // maximum age of last known good values
+)
+
+// meterReading is a single meter's power and, for batteries, soc
+type meterReading struct {
+	power, soc  float64
+	err, socErr error
+	updated     time.Time // measurement time
+	latency     time.Duration
+}
+
+// meterDevice reads a meter asynchronously and keeps its last known good values
+type meterDevice struct {
+	log     *util.Logger
+	name    string
+	meter   api.Meter
+	pending chan meterReading // read in progress
+
+	now   func() time.Time                     // mockable time
+	after func(time.Duration) <-chan time.Time // mockable deadline
+
+	power, soc               float64   // last known good values
+	powerUpdated, socUpdated time.Time // last known good timestamps
+}
+
+// measure reads the meter and sends the result
+func (d *meterDevice) measure(meter api.Meter, res chan<- meterReading) {
+	var r meterReading
+	start := d.now()
+
+	r.err = retry.Do(func() error {
+		var err error
+		r.power, err = meter.CurrentPower()
+		return err
+	}, retryOptions...)
+
+	if m, ok := meter.(api.Battery); ok {
+		r.soc, r.socErr = m.Soc()
+	}
+
+	r.updated = d.now()
+	r.latency = r.updated.Sub(start)
+	res <- r
+}
+
+// read returns the meter reading within the deadline.
+// Failed or late values are replaced by the last known good values within the maximum age.
+func (d *meterDevice) read(timeout, maxAge time.Duration) meterReading {
+	// don't stack reads if the previous read is still in progress
+	if d.pending == nil {
+		d.pending = make(chan meterReading, 1)
+		go d.measure(d.meter, d.pending)
+	}
+
+	var r meterReading
+
+	select {
+	case r = <-d.pending:
+		d.pending = nil
+	case <-d.after(timeout):
+		r = meterReading{err: api.ErrTimeout, socErr: api.ErrTimeout, latency: timeout}
+	}
+
+	now := d.now()
+
+	// late reads are accounted at their measurement time
+	if r.err == nil {
+		d.power, d.powerUpdated = r.power, r.updated
+	}
+
+	if age := now.Sub(d.powerUpdated); age >= maxAge {
+		if r.err == nil {
+			r.err = api.ErrOutdated
+		}
+	} else if r.err != nil {
+		d.log.WARN.Printf("%s power: %v, using last value from %v ago", d.name, r.err, age.Truncate(time.Second))
+		r.power, r.err = d.power, nil
+	}
+
+	if _, ok := d.meter.(api.Battery); ok {
+		if r.socErr == nil {
+			d.soc, d.socUpdated = r.soc, r.updated
+		}
+
+		if age := now.Sub(d.socUpdated); age >= maxAge {
+			if r.socErr == nil {
+				r.socErr = api.ErrOutdated
+			}
+		} else if r.socErr != nil {
+			d.log.WARN.Printf("%s soc: %v, using last value from %v ago", d.name, r.socErr, age.Truncate(time.Second))
+			r.soc, r.socErr = d.soc, nil
+		}
+	}
+
+	return r
+}
+
+// meterDevice returns the named meter device, creating it if required
+func (site *Site) meterDevice(name string, meter api.Meter) *meterDevice {
+	if site.meterDevices == nil {
+		site.meterDevices = make(map[string]*meterDevice)
+	}
+
+	d, ok := site.meterDevices[name]
+	if !ok {
+		d = &meterDevice{
+			log:   site.log,
+			name:  name,
+			meter: meter,
+			now:   site.clock.Now,
+			after: site.clock.After,
+		}
+		site.meterDevices[name] = d
+	}
+
+	// meter may have been replaced once available
+	d.meter = meter
+
+	return d
+}
+
+// updateDevices replaces meters and vehicles that failed to initialize
+// with the live devices once available to pick up their capabilities
+func (site *Site) updateDevices() {
+	if mt, ok := liveDevice(site.gridMeter); ok {
+		site.gridMeter = mt
+	}
+
+	for i, pv := range site.pvMeters {
+		if mt, ok := liveDevice(pv); ok {
+			site.pvMeters[i] = mt
+
+			if c, ok := mt.(api.Curtailer); ok && site.curtailing() {
+				site.curtailers = append(site.curtailers, curtailer{Curtailer: c, id: i, limit: -1})
+			}
+		}
+	}
+
+	for _, meters := range [][]api.Meter{site.batteryMeters, site.auxMeters} {
+		for i, m := range meters {
+			if mt, ok := liveDevice(m); ok {
+				meters[i] = mt
+			}
+		}
+	}
+
+	var vehicles bool
+	for _, v := range site.GetVehicles() {
+		if vv, ok := liveDevice(v); ok {
+			vehicles = true
+			site.coordinator.Replace(v, vv)
+
+			for _, lp := range site.loadpoints {
+				lp.replaceVehicle(v, vv)
+			}
+		}
+	}
+
+	if vehicles {
+		site.publish("vehicles", vehicleTitles(site.GetVehicles()))
+	}
+
+	for _, lp := range site.loadpoints {
+		lp.updateDevices()
+	}
+}
+
+// readMeters reads all site meters concurrently with per-meter deadline.
+// Readings are keyed by meter name, i.e. grid, pv1, battery1, aux1.
+func (site *Site) readMeters() map[string]meterReading {
+	devices := make([]*meterDevice, 0, 1+len(site.pvMeters)+len(site.batteryMeters)+len(site.auxMeters))
+
+	if site.gridMeter != nil {
+		devices = append(devices, site.meterDevice("grid", site.gridMeter))
+	}
+
+	for class, meters := range map[string][]api.Meter{
+		"pv":      site.pvMeters,
+		"battery": site.batteryMeters,
+		"aux":     site.auxMeters,
+	} {
+		for i, meter := range meters {
+			devices = append(devices, site.meterDevice(fmt.Sprintf("%s%d", class, i+1), meter))
+		}
+	}
+
+	site.snapshot = site.clock.Now()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	res := make(map[string]meterReading, len(devices))
+
+	for _, d := range devices {
+		wg.Add(1)
+
+		go func(d *meterDevice) {
+			defer wg.Done()
+
+			r := d.read(meterTimeout, meterMaxAge)
+
+			mu.Lock()
+			res[d.name] = r
+			mu.Unlock()
+		}(d)
+	}
+
+	wg.Wait()
+
+	site.log.DEBUG.Printf("meters read in %v", site.clock.Since(site.snapshot).Truncate(time.Millisecond))
+	site.publish("metersUpdated", site.snapshot)
+
+	return res
+}
+
+// latency converts the read latency to milliseconds for publishing
+func (r meterReading) latencyMs() float64 {
+	return float64(r.latency.Milliseconds())
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/coordinator"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type meterFunc func() (float64, error)
+
+func (f meterFunc) CurrentPower() (float64, error) {
+	return f()
+}
+
+// blockingMeter signals when read and returns power once released
+func blockingMeter(power float64, started chan<- struct{}, release <-chan struct{}) meterFunc {
+	return func() (float64, error) {
+		started <- struct{}{}
+		<-release
+		return power, nil
+	}
+}
+
+func TestReadMetersConcurrently(t *testing.T) {
+	clck := clock.NewMock()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	site := &Site{
+		log:       util.NewLogger("foo"),
+		clock:     clck,
+		gridMeter: blockingMeter(100, started, release),
+		pvMeters: []api.Meter{
+			blockingMeter(1000, started, release),
+			blockingMeter(2000, started, release),
+		},
+	}
+
+	resC := make(chan map[string]meterReading)
+	go func() {
+		resC <- site.readMeters()
+	}()
+
+	// all meters are read before any read completes
+	for i := 0; i < 3; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			require.Fail(t, "meters not read concurrently")
+		}
+	}
+
+	clck.Add(200 * time.Millisecond)
+	close(release)
+
+	res := <-resC
+	assert.Equal(t, 100.0, res["grid"].power)
+	assert.Equal(t, 1000.0, res["pv1"].power)
+	assert.Equal(t, 2000.0, res["pv2"].power)
+	assert.Equal(t, 200*time.Millisecond, res["pv1"].latency)
+}
+
+func TestReadMeterLastKnownGood(t *testing.T) {
+	clck := clock.NewMock()
+
+	var (
+		calls    int
+		power    = 100.0
+		err      error
+		release  chan struct{}
+		deadline chan time.Time
+	)
+
+	d := &meterDevice{
+		log:  util.NewLogger("foo"),
+		name: "grid",
+		meter: meterFunc(func() (float64, error) {
+			calls++
+			if release != nil {
+				<-release
+			}
+			return power, err
+		}),
+		now: clck.Now,
+		after: func(time.Duration) <-chan time.Time {
+			return deadline
+		},
+	}
+
+	// good value
+	r := d.read(time.Second, time.Minute)
+	assert.NoError(t, r.err)
+	assert.Equal(t, 100.0, r.power)
+
+	// timeout uses last known good value
+	power, release = 200, make(chan struct{})
+	deadline = make(chan time.Time, 1)
+	deadline <- clck.Now()
+
+	r = d.read(time.Second, time.Minute)
+	assert.NoError(t, r.err)
+	assert.Equal(t, 100.0, r.power)
+
+	// pending read is not repeated and completes
+	deadline = nil
+	close(release)
+
+	r = d.read(time.Second, time.Minute)
+	assert.NoError(t, r.err)
+	assert.Equal(t, 200.0, r.power)
+	assert.Equal(t, 2, calls)
+
+	// error beyond maximum age
+	release, err = nil, errors.New("foo")
+	clck.Add(2 * time.Minute)
+
+	r = d.read(time.Second, time.Minute)
+	assert.Error(t, r.err)
+
+	// late read is accounted at measurement time
+	d.pending = make(chan meterReading, 1)
+	d.pending <- meterReading{power: 300, updated: clck.Now()}
+	clck.Add(2 * time.Minute)
+
+	r = d.read(time.Second, time.Minute)
+	assert.ErrorIs(t, r.err, api.ErrOutdated)
+	assert.Equal(t, clck.Now().Add(-2*time.Minute), d.powerUpdated)
+}
+
+// offlineMeter is a wrapped meter that becomes available once live is set
+type offlineMeter struct {
+	meterFunc
+	live api.Meter
+}
+
+func (m *offlineMeter) Live() api.Meter {
+	return m.live
+}
+
+type curtailMeter struct {
+	meterFunc
+	curtailFunc
+}
+
+func TestUpdateDevices(t *testing.T) {
+	pv := &offlineMeter{meterFunc: func() (float64, error) {
+		return 0, errors.New("offline")
+	}}
+
+	site := &Site{
+		log:           util.NewLogger("foo"),
+		clock:         clock.NewMock(),
+		MaxGridExport: new(float64),
+		pvMeters:      []api.Meter{pv},
+		coordinator:   coordinator.New(util.NewLogger("foo"), nil),
+	}
+
+	// still offline
+	site.updateDevices()
+	assert.Equal(t, pv, site.pvMeters[0])
+	assert.Empty(t, site.curtailers)
+
+	// live meter replaces wrapper and provides curtailment
+	pv.live = &curtailMeter{
+		meterFunc:   func() (float64, error) { return 1000, nil },
+		curtailFunc: func(float64) error { return nil },
+	}
+
+	site.updateDevices()
+	assert.Equal(t, pv.live, site.pvMeters[0])
+	assert.Len(t, site.curtailers, 1)
+
+	res := site.readMeters()
+	assert.Equal(t, 1000.0, res["pv1"].power)
+}
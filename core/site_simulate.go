@@ -0,0 +1,41 @@
+package core
+
+import (
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Simulate prepares the site for being stepped in virtual time instead of Run.
+// Unlike Prepare, loadpoints publish to their own channels synchronously such that
+// values can be attributed to the virtual time of the current step.
+func (site *Site) Simulate(clck clock.Clock, uiChan chan<- util.Param, lpUIChans []chan<- util.Param, lpPushChans []chan<- push.Event) {
+	site.clock = clck
+	site.savings.clock = clck
+	site.savings.updated = clck.Now()
+
+	for _, lp := range site.loadpoints {
+		lp.clock = clck
+		lp.wakeUpTimer.clck = clck
+		lp.planner.SetClock(clck)
+	}
+
+	site.uiChan = uiChan
+	site.lpUpdateChan = make(chan *Loadpoint, 1)
+	site.prepare()
+
+	for id, lp := range site.loadpoints {
+		lp.Prepare(lpUIChans[id], lpPushChans[id], site.lpUpdateChan)
+	}
+}
+
+// Step runs a single control cycle for the loadpoint.
+// Loadpoint update requests are dropped since all loadpoints are stepped in turn.
+func (site *Site) Step(id int) {
+	site.update(site.loadpoints[id])
+
+	select {
+	case <-site.lpUpdateChan:
+	default:
+	}
+}
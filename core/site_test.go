@@ -2,8 +2,12 @@ package core
 
 import (
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSitePower(t *testing.T) {
@@ -75,3 +79,73 @@ func TestGreenShare(t *testing.T) {
 		}
 	}
 }
+
+func TestRunEvents(t *testing.T) {
+	clck := clock.NewMock()
+	power := 1000.0
+
+	site := &Site{
+		log:   util.NewLogger("foo"),
+		clock: clck,
+		gridMeter: meterFunc(func() (float64, error) {
+			return power, nil
+		}),
+		Event: &EventConfig{Deadband: 50, MinInterval: 2 * time.Second},
+	}
+
+	next := make(chan Updater, 10)
+	for i := 0; i < cap(next); i++ {
+		next <- nil
+	}
+
+	updated := make(chan struct{}, 10)
+	update := func(Updater) {
+		site.gridPower = power
+		updated <- struct{}{}
+	}
+
+	site.lpUpdateChan = make(chan *Loadpoint)
+
+	stopC := make(chan struct{})
+	defer close(stopC)
+
+	eventC := make(chan struct{})
+	go site.run(stopC, time.Minute, eventC, next, update)
+
+	expectUpdate := func(msg string) {
+		select {
+		case <-updated:
+		case <-time.After(time.Second):
+			require.Fail(t, msg)
+		}
+	}
+
+	// loadpoint update request is processed after the event
+	expectNoUpdate := func(msg string) {
+		eventC <- struct{}{}
+		site.lpUpdateChan <- nil
+		expectUpdate("loadpoint update")
+		assert.Empty(t, updated, msg)
+	}
+
+	expectUpdate("initial update")
+
+	// min interval not elapsed
+	power = 1200
+	expectNoUpdate("min interval")
+
+	// grid power within deadband
+	clck.Add(3 * time.Second)
+	power = 1220
+	expectNoUpdate("deadband")
+
+	// grid power changed
+	clck.Add(3 * time.Second)
+	power = 1400
+	eventC <- struct{}{}
+	expectUpdate("grid power changed")
+
+	// polling fallback
+	clck.Add(time.Minute)
+	expectUpdate("polling fallback")
+}
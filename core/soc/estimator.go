@@ -27,6 +27,21 @@ type Estimator struct {
 	prevSoc           float64 // previous vehicle Soc in %
 	prevChargedEnergy float64 // previous charged energy in Wh
 	energyPerSocStep  float64 // Energy per Soc percent in Wh
+	learnedCapacity   float64 // virtual capacity learned during current session in Wh
+
+	throttled         bool      // vehicle api must not be polled
+	pollVehicle       bool      // poll vehicle api in addition to charger soc
+	chargerSoc        float64   // last charger soc
+	chargerSocUpdated time.Time // last charger soc change
+	apiSoc            float64   // last vehicle api soc
+	apiSocUpdated     time.Time // last vehicle api soc change, zero if unchanged since first value
+
+	socSet    bool    // soc has been set for vehicle without soc api
+	setSoc    float64 // soc entered by user or restored from last session
+	setEnergy float64 // charged energy when soc was set
+
+	store *Store // learned model persistence
+	model *Model // learned vehicle battery model
 }
 
 // NewEstimator creates new estimator
@@ -38,6 +53,11 @@ func NewEstimator(log *util.Logger, charger api.Charger, vehicle api.Vehicle, es
 		estimate: estimate,
 	}
 
+	if Models != nil {
+		s.store = Models
+		s.model = Models.Model(Models.Key(vehicle))
+	}
+
 	s.Reset()
 
 	return s
@@ -45,16 +65,84 @@ func NewEstimator(log *util.Logger, charger api.Charger, vehicle api.Vehicle, es
 
 // Reset resets the estimation process to default values
 func (s *Estimator) Reset() {
+	// remember capacity learned during previous session
+	if s.model != nil && s.learnedCapacity > 0 {
+		s.model.learnCapacity(s.learnedCapacity, s.capacity)
+		s.store.Save(s.model)
+	}
+	s.learnedCapacity = 0
+
+	s.socSet = false
+	s.chargerSoc, s.chargerSocUpdated = 0, time.Time{}
+	s.apiSoc, s.apiSocUpdated = 0, time.Time{}
 	s.prevSoc = 0
 	s.prevChargedEnergy = 0
 	s.initialSoc = 0
 	s.capacity = float64(s.vehicle.Capacity()) * 1e3  // cache to simplify debugging
 	s.virtualCapacity = s.capacity / ChargeEfficiency // initial capacity taking efficiency into account
+	if s.model != nil {
+		if capacity := s.model.virtualCapacity(); capacity > 0 {
+			s.virtualCapacity = capacity
+		}
+	}
 	s.energyPerSocStep = s.virtualCapacity / 100
 }
 
+// SetSoc sets the soc of a vehicle without soc api.
+// Soc is estimated from the energy charged from now on.
+func (s *Estimator) SetSoc(soc, chargedEnergy float64) {
+	s.socSet = true
+	s.setSoc = soc
+	s.setEnergy = chargedEnergy
+	s.vehicleSoc = soc
+}
+
+// PollVehicle allows polling the vehicle api in addition to charger-provided soc.
+// If both are available, the more recently updated soc is used.
+func (s *Estimator) PollVehicle(poll bool) {
+	s.pollVehicle = poll
+}
+
+// Throttle suspends polling the vehicle api, e.g. if the api quota is exhausted.
+// Soc is estimated from the last known vehicle soc meanwhile.
+func (s *Estimator) Throttle(throttled bool) {
+	s.throttled = throttled
+}
+
+// Estimated returns true if soc is estimated from charged energy only
+func (s *Estimator) Estimated() bool {
+	return s.socSet
+}
+
+// Sample learns charge power and taper curve from a charge power measured at maximum current
+func (s *Estimator) Sample(chargePower float64, phases int) {
+	if s.model != nil && s.vehicleSoc > 0 {
+		s.model.learnPower(s.vehicleSoc, chargePower, phases)
+	}
+}
+
+// MaxPower returns the learned typical maximum charge power for given phases
+func (s *Estimator) MaxPower(phases int) float64 {
+	if s.model == nil {
+		return 0
+	}
+	return s.model.maxPower(phases)
+}
+
+// Tapered returns true if the remaining duration takes a learned charge curve into account
+func (s *Estimator) Tapered() bool {
+	return s.model != nil && s.model.tapered()
+}
+
 // RemainingChargeDuration returns the estimated remaining duration
 func (s *Estimator) RemainingChargeDuration(targetSoc int, chargePower float64) time.Duration {
+	if s.Tapered() {
+		if float64(targetSoc) <= s.vehicleSoc || chargePower <= 0 {
+			return 0
+		}
+		return s.model.duration(s.vehicleSoc, float64(targetSoc), s.energyPerSocStep, chargePower)
+	}
+
 	energy := s.RemainingChargeEnergy(targetSoc) * 1e3 / chargePower
 	if math.IsInf(energy, 0) {
 		energy = 0
@@ -74,6 +162,28 @@ func (s *Estimator) RemainingChargeEnergy(targetSoc int) float64 {
 	return whRemaining / 1e3
 }
 
+// mergeVehicleSoc returns the vehicle api soc if it has been updated more recently than the charger soc
+func (s *Estimator) mergeVehicleSoc() (float64, bool) {
+	f, err := s.vehicle.Soc()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			s.log.DEBUG.Printf("vehicle soc: %v (using charger)", err)
+		}
+		return 0, false
+	}
+
+	switch {
+	case s.apiSoc == 0:
+		// first value, freshness unknown
+		s.apiSoc = f
+	case f != s.apiSoc:
+		s.apiSoc = f
+		s.apiSocUpdated = time.Now()
+	}
+
+	return f, s.apiSocUpdated.After(s.chargerSocUpdated)
+}
+
 // Soc replaces the api.Vehicle.Soc interface to take charged energy into account
 func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 	var fetchedSoc *float64
@@ -81,7 +191,7 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 	if charger, ok := s.charger.(api.Battery); ok {
 		f, err := charger.Soc()
 
-		// if the charger does or could provide Soc, we always use it instead of using the vehicle API
+		// if the charger does or could provide Soc, we use it instead of polling the vehicle API
 		if err == nil || !errors.Is(err, api.ErrNotAvailable) {
 			if err != nil {
 				// never received a soc value
@@ -92,6 +202,9 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 				// recover from temporary api errors
 				f = s.prevSoc
 				s.log.WARN.Printf("vehicle soc (charger): %v (ignored by estimator)", err)
+			} else if f != s.chargerSoc || s.chargerSocUpdated.IsZero() {
+				s.chargerSoc = f
+				s.chargerSocUpdated = time.Now()
 			}
 
 			fetchedSoc = &f
@@ -99,6 +212,38 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 		}
 	}
 
+	// merge charger and vehicle api soc by freshness
+	if fetchedSoc != nil && s.pollVehicle {
+		if f, ok := s.mergeVehicleSoc(); ok {
+			fetchedSoc = &f
+			s.vehicleSoc = f
+		}
+	}
+
+	// vehicle without soc api
+	if fetchedSoc == nil && s.socSet {
+		energyDelta := math.Max(chargedEnergy-s.setEnergy, 0)
+		s.vehicleSoc = math.Min(s.setSoc+energyDelta/s.energyPerSocStep, 100)
+		s.log.DEBUG.Printf("soc estimated: %.2f%% (set: %.0f%%)", s.vehicleSoc, s.setSoc)
+
+		return s.vehicleSoc, nil
+	}
+
+	// vehicle api throttled, continue from last known soc
+	if fetchedSoc == nil && s.throttled {
+		f := s.prevSoc
+		if f == 0 {
+			f = s.vehicleSoc
+		}
+
+		if f == 0 {
+			return 0, api.ErrMustRetry
+		}
+
+		fetchedSoc = &f
+		s.vehicleSoc = f
+	}
+
 	if fetchedSoc == nil {
 		f, err := s.vehicle.Soc()
 		if err != nil {
@@ -156,6 +301,7 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 				if socDiff > 10 && energyDiff > 0 {
 					s.energyPerSocStep = energyDiff / socDiff
 					s.virtualCapacity = s.energyPerSocStep * 100
+					s.learnedCapacity = s.virtualCapacity
 					s.log.DEBUG.Printf("soc gradient updated: soc: %.1f%%, socDiff: %.1f%%, energyDiff: %.0fWh, energyPerSocStep: %.1fWh, virtualCapacity: %.0fWh", s.vehicleSoc, socDiff, energyDiff, s.energyPerSocStep, s.virtualCapacity)
 				}
 			}
@@ -207,3 +207,79 @@ func TestSocFromChargerAndVehicleWithErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestSetSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	// 9 kWh userBatCap => 10 kWh virtualBatCap
+	vehicle.EXPECT().Capacity().Return(float64(9)).AnyTimes()
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, vehicle, true)
+	ce.SetSoc(40, 500)
+
+	if !ce.Estimated() {
+		t.Error("soc not estimated")
+	}
+
+	// vehicle soc api is not used
+	for energy, soc := range map[float64]float64{
+		500:  40,
+		1500: 50,
+		9000: 100,
+	} {
+		if f, err := ce.Soc(energy); err != nil || f != soc {
+			t.Errorf("expected soc %.0f%%, got %.1f%% (%v)", soc, f, err)
+		}
+	}
+
+	ce.Reset()
+	if ce.Estimated() {
+		t.Error("soc still estimated after reset")
+	}
+}
+
+func TestMergeChargerSoc(t *testing.T) {
+	type chargerStruct struct {
+		*mock.MockCharger
+		*mock.MockBattery
+	}
+
+	ctrl := gomock.NewController(t)
+	vehicle := mock.NewMockVehicle(ctrl)
+	charger := &chargerStruct{mock.NewMockCharger(ctrl), mock.NewMockBattery(ctrl)}
+
+	vehicle.EXPECT().Capacity().Return(float64(10)).AnyTimes()
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
+
+	// vehicle api not polled
+	charger.MockBattery.EXPECT().Soc().Return(20.0, nil)
+	if f, _ := ce.Soc(0); f != 20 {
+		t.Errorf("expected charger soc, got %.0f%%", f)
+	}
+
+	ce.PollVehicle(true)
+
+	// unchanged vehicle soc has unknown freshness
+	charger.MockBattery.EXPECT().Soc().Return(20.0, nil)
+	vehicle.EXPECT().Soc().Return(10.0, nil)
+	if f, _ := ce.Soc(0); f != 20 {
+		t.Errorf("expected charger soc, got %.0f%%", f)
+	}
+
+	// vehicle soc updated
+	charger.MockBattery.EXPECT().Soc().Return(20.0, nil)
+	vehicle.EXPECT().Soc().Return(30.0, nil)
+	if f, _ := ce.Soc(0); f != 30 {
+		t.Errorf("expected vehicle soc, got %.0f%%", f)
+	}
+
+	// charger soc updated
+	charger.MockBattery.EXPECT().Soc().Return(31.0, nil)
+	vehicle.EXPECT().Soc().Return(30.0, nil)
+	if f, _ := ce.Soc(0); f != 31 {
+		t.Errorf("expected charger soc, got %.0f%%", f)
+	}
+}
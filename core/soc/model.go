@@ -0,0 +1,187 @@
+package soc
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	modelSmoothing = 0.3  // weight of a new session when updating the learned capacity
+	taperSmoothing = 0.1  // weight of a new sample when updating the taper curve
+	powerDecay     = 0.02 // weight of a lower sample when updating the typical max power
+	taperSteps     = 10   // taper curve resolution in 10% soc steps
+	minTaperSteps  = 3    // populated taper steps required before the curve is used
+)
+
+// Model is the learned battery model of a vehicle
+type Model struct {
+	mu         sync.Mutex
+	Vehicle    string          `json:"vehicle" gorm:"primarykey"`
+	Capacity   float64         `json:"capacity"`                        // energy at charger per 100% soc in kWh
+	Efficiency float64         `json:"efficiency"`                      // nominal to effective capacity ratio
+	Taper      []float64       `json:"taper" gorm:"serializer:json"`    // relative charge power per 10% soc step
+	MaxPower   map[int]float64 `json:"maxPower" gorm:"serializer:json"` // typical max charge power in W per phase count
+	Sessions   int             `json:"sessions"`                        // number of sessions capacity was learned from
+	Updated    time.Time       `json:"updated"`
+}
+
+// TableName implements gorm's Tabler interface
+func (*Model) TableName() string {
+	return "vehicle_models"
+}
+
+// reset clears all learned parameters
+func (m *Model) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Capacity = 0
+	m.Efficiency = 0
+	m.Taper = nil
+	m.MaxPower = nil
+	m.Sessions = 0
+	m.Updated = time.Time{}
+}
+
+// snapshot returns a copy of the model
+func (m *Model) snapshot() *Model {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res := &Model{
+		Vehicle:    m.Vehicle,
+		Capacity:   m.Capacity,
+		Efficiency: m.Efficiency,
+		Taper:      append([]float64(nil), m.Taper...),
+		MaxPower:   make(map[int]float64, len(m.MaxPower)),
+		Sessions:   m.Sessions,
+		Updated:    m.Updated,
+	}
+
+	for k, v := range m.MaxPower {
+		res.MaxPower[k] = v
+	}
+
+	return res
+}
+
+// virtualCapacity returns the learned energy per 100% soc in Wh
+func (m *Model) virtualCapacity() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.Capacity * 1e3
+}
+
+// learnCapacity folds the virtual capacity in Wh observed during a session into the model
+func (m *Model) learnCapacity(virtualCapacity, nominalCapacity float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	capacity := virtualCapacity / 1e3
+	if m.Capacity == 0 {
+		m.Capacity = capacity
+	} else {
+		m.Capacity += modelSmoothing * (capacity - m.Capacity)
+	}
+
+	if nominalCapacity > 0 {
+		m.Efficiency = math.Min(nominalCapacity/1e3/m.Capacity, 1)
+	}
+
+	m.Sessions++
+	m.Updated = time.Now()
+}
+
+// taperStep returns the taper curve index for given soc
+func taperStep(soc float64) int {
+	return int(math.Min(math.Max(soc, 0), 99.9) / (100 / taperSteps))
+}
+
+// learnPower updates max power and taper curve from a charge power sample taken at max current
+func (m *Model) learnPower(soc, power float64, phases int) {
+	if power <= 0 || phases == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.MaxPower == nil {
+		m.MaxPower = make(map[int]float64)
+	}
+
+	// follow higher values immediately, decay slowly below the taper region
+	switch max := m.MaxPower[phases]; {
+	case power > max:
+		m.MaxPower[phases] = power
+	case soc < 50:
+		m.MaxPower[phases] += powerDecay * (power - max)
+	}
+
+	if len(m.Taper) != taperSteps {
+		m.Taper = make([]float64, taperSteps)
+	}
+
+	ratio := math.Min(power/m.MaxPower[phases], 1)
+
+	step := taperStep(soc)
+	if m.Taper[step] == 0 {
+		m.Taper[step] = ratio
+	} else {
+		m.Taper[step] += taperSmoothing * (ratio - m.Taper[step])
+	}
+
+	m.Updated = time.Now()
+}
+
+// maxPower returns the typical max charge power for given phases
+func (m *Model) maxPower(phases int) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.MaxPower[phases]
+}
+
+// tapered returns true if a taper curve has been learned
+func (m *Model) tapered() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var steps int
+	for _, t := range m.Taper {
+		if t > 0 {
+			steps++
+		}
+	}
+
+	return steps >= minTaperSteps
+}
+
+// taper returns the relative charge power at given soc, defaulting to full power if unknown
+func (m *Model) taper(soc float64) float64 {
+	if len(m.Taper) != taperSteps {
+		return 1
+	}
+	if t := m.Taper[taperStep(soc)]; t > 0 {
+		return t
+	}
+	return 1
+}
+
+// duration returns the charge duration from soc to targetSoc using the taper curve.
+// Power is the charge power at the current soc.
+func (m *Model) duration(soc, targetSoc, energyPerSocStep, power float64) time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// full power outside of taper region
+	fullPower := power / m.taper(soc)
+
+	var hours float64
+	for s := soc; s < targetSoc; s = math.Floor(s) + 1 {
+		step := math.Min(math.Floor(s)+1, targetSoc) - s
+		hours += step * energyPerSocStep / (fullPower * m.taper(s))
+	}
+
+	return time.Duration(float64(time.Hour) * hours).Round(time.Second)
+}
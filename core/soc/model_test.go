@@ -0,0 +1,110 @@
+package soc
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/mock"
+	"github.com/glebarez/sqlite"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestModelDuration(t *testing.T) {
+	m := &Model{Vehicle: "foo"}
+
+	// full power up to 80%, half power above
+	for soc := 5.0; soc < 100; soc += 10 {
+		power := 11000.0
+		if soc > 80 {
+			power = 5500
+		}
+		m.learnPower(soc, power, 3)
+	}
+
+	assert.Equal(t, 11000.0, m.maxPower(3))
+	assert.True(t, m.tapered())
+
+	// 100Wh per soc step
+	assert.Equal(t, 10*time.Minute, m.duration(70, 80, 100, 6000))
+	assert.Equal(t, 30*time.Minute, m.duration(70, 90, 100, 6000))
+}
+
+func TestModelTapered(t *testing.T) {
+	m := &Model{Vehicle: "foo"}
+
+	// single sample is not a learned curve
+	m.learnPower(85, 5500, 3)
+	assert.False(t, m.tapered())
+
+	m.learnPower(95, 3000, 3)
+	assert.False(t, m.tapered())
+
+	m.learnPower(75, 5500, 3)
+	assert.True(t, m.tapered())
+}
+
+func TestModelStore(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), new(gorm.Config))
+	require.NoError(t, err)
+
+	s, err := NewStore(db)
+	require.NoError(t, err)
+
+	m := s.Model("foo")
+	m.learnCapacity(50e3, 45e3)
+	m.learnPower(20, 7000, 1)
+	s.Save(m)
+
+	// reload from database
+	s, err = NewStore(db)
+	require.NoError(t, err)
+
+	res := s.Get("foo")
+	assert.Equal(t, 50.0, res.Capacity)
+	assert.Equal(t, 0.9, res.Efficiency)
+	assert.Equal(t, 7000.0, res.MaxPower[1])
+	assert.Equal(t, 1, res.Sessions)
+
+	// subsequent session
+	s.Model("foo").learnCapacity(60e3, 45e3)
+	assert.Equal(t, 53.0, s.Get("foo").Capacity)
+
+	require.NoError(t, s.Reset("foo"))
+	assert.Zero(t, s.Get("foo").Capacity)
+
+	s, err = NewStore(db)
+	require.NoError(t, err)
+	assert.Zero(t, s.Get("foo").Sessions)
+}
+
+func TestModelStoreKey(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), new(gorm.Config))
+	require.NoError(t, err)
+
+	s, err := NewStore(db)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+
+	v1 := mock.NewMockVehicle(ctrl)
+	v1.EXPECT().Title().Return("Car").AnyTimes()
+	v2 := mock.NewMockVehicle(ctrl)
+	v2.EXPECT().Title().Return("Car").AnyTimes()
+
+	s.Register(v1, "first")
+	s.Register(v2, "second")
+
+	// same title does not share the model
+	assert.Equal(t, "first", s.Key(v1))
+	assert.Equal(t, "second", s.Key(v2))
+	assert.NotSame(t, s.Model(s.Key(v1)), s.Model(s.Key(v2)))
+
+	// unregistered vehicles fall back to title
+	v3 := mock.NewMockVehicle(ctrl)
+	v3.EXPECT().Title().Return("Other")
+	assert.Equal(t, "Other", s.Key(v3))
+}
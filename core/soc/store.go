@@ -0,0 +1,110 @@
+package soc
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"gorm.io/gorm"
+)
+
+// Models is the vehicle model store if database is configured
+var Models *Store
+
+// Store persists learned vehicle battery models
+type Store struct {
+	mu     sync.Mutex
+	log    *util.Logger
+	db     *gorm.DB
+	models map[string]*Model
+	names  map[api.Vehicle]string
+}
+
+// NewStore creates a vehicle model store
+func NewStore(db *gorm.DB) (*Store, error) {
+	s := &Store{
+		log:    util.NewLogger("soc"),
+		db:     db,
+		models: make(map[string]*Model),
+		names:  make(map[api.Vehicle]string),
+	}
+
+	return s, db.AutoMigrate(new(Model))
+}
+
+// Register associates the vehicle with its unique config name used as model key
+func (s *Store) Register(v api.Vehicle, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.names[v] = name
+}
+
+// Key returns the vehicle's model key, falling back to the title for unregistered vehicles
+func (s *Store) Key(v api.Vehicle) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if name, ok := s.names[v]; ok {
+		return name
+	}
+
+	return v.Title()
+}
+
+// Model returns the model of the vehicle, loading it from the database if required
+func (s *Store) Model(vehicle string) *Model {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if m, ok := s.models[vehicle]; ok {
+		return m
+	}
+
+	m := &Model{Vehicle: vehicle}
+	if err := s.db.Where(&Model{Vehicle: vehicle}).First(m).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.log.ERROR.Printf("load model %s: %v", vehicle, err)
+	}
+
+	s.models[vehicle] = m
+
+	return m
+}
+
+// Get returns a copy of the vehicle's model
+func (s *Store) Get(vehicle string) *Model {
+	return s.Model(vehicle).snapshot()
+}
+
+// Save persists the model
+func (s *Store) Save(m *Model) {
+	if m.Vehicle == "" {
+		return
+	}
+
+	if err := s.db.Save(m.snapshot()).Error; err != nil {
+		s.log.ERROR.Printf("save model %s: %v", m.Vehicle, err)
+	}
+}
+
+// Reset clears the vehicle's learned model
+func (s *Store) Reset(vehicle string) error {
+	s.Model(vehicle).reset()
+	return s.db.Delete(&Model{Vehicle: vehicle}).Error
+}
+
+// Persist saves all loaded models
+func (s *Store) Persist() {
+	s.mu.Lock()
+	models := make([]*Model, 0, len(s.models))
+	for _, m := range s.models {
+		models = append(models, m)
+	}
+	s.mu.Unlock()
+
+	for _, m := range models {
+		if m.snapshot().Sessions > 0 || m.tapered() {
+			s.Save(m)
+		}
+	}
+}
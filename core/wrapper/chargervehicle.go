@@ -0,0 +1,78 @@
+package wrapper
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+// ChargerVehicle is an implicit vehicle created from the vehicle data reported by the charger.
+// It is used if the charger provides soc but no configured vehicle matches.
+type ChargerVehicle struct {
+	charger api.Charger
+	title   string
+	id      string
+}
+
+// NewChargerVehicle creates an implicit vehicle for the charger's vehicle data
+func NewChargerVehicle(charger api.Charger, id string) *ChargerVehicle {
+	title := "Vehicle"
+	if id != "" {
+		title += " " + id
+	}
+
+	return &ChargerVehicle{
+		charger: charger,
+		title:   title,
+		id:      id,
+	}
+}
+
+var _ api.Vehicle = (*ChargerVehicle)(nil)
+
+// Soc implements the api.Vehicle interface
+func (v *ChargerVehicle) Soc() (float64, error) {
+	if c, ok := v.charger.(api.Battery); ok {
+		return c.Soc()
+	}
+	return 0, api.ErrNotAvailable
+}
+
+// Capacity implements the api.Vehicle interface
+func (v *ChargerVehicle) Capacity() float64 {
+	if c, ok := v.charger.(api.BatteryCapacity); ok {
+		return c.Capacity()
+	}
+	return 0
+}
+
+// Icon implements the api.Vehicle interface
+func (v *ChargerVehicle) Icon() string {
+	return "car"
+}
+
+// Title implements the api.Vehicle interface
+func (v *ChargerVehicle) Title() string {
+	return v.title
+}
+
+// SetTitle implements the api.Vehicle interface
+func (v *ChargerVehicle) SetTitle(title string) {
+	v.title = title
+}
+
+// Phases implements the api.Vehicle interface
+func (v *ChargerVehicle) Phases() int {
+	return 0
+}
+
+// Identifiers implements the api.Vehicle interface
+func (v *ChargerVehicle) Identifiers() []string {
+	if v.id == "" {
+		return nil
+	}
+	return []string{v.id}
+}
+
+// OnIdentified implements the api.Vehicle interface
+func (v *ChargerVehicle) OnIdentified() api.ActionConfig {
+	return api.ActionConfig{}
+}
@@ -3,6 +3,7 @@ package meter
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/provider"
@@ -13,7 +14,7 @@ func init() {
 	registry.Add(api.Custom, NewConfigurableFromConfig)
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64"
+//go:generate go run ../cmd/tools/decorate.go -f decorateMeter -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.Curtailer,Curtail,func(limit float64) error"
 
 // NewConfigurableFromConfig creates api.Meter from config
 func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error) {
@@ -25,6 +26,7 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error)
 		Currents []provider.Config // optional
 		Voltages []provider.Config // optional
 		Powers   []provider.Config // optional
+		Curtail  *provider.Config  // optional
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -74,7 +76,20 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Meter, error)
 		}
 	}
 
-	res := m.Decorate(totalEnergyG, currentsG, voltagesG, powersG, batterySocG, cc.capacity.Decorator())
+	// decorate curtailment
+	var curtailS func(float64) error
+	if cc.Curtail != nil {
+		limitS, err := provider.NewIntSetterFromConfig("limit", *cc.Curtail)
+		if err != nil {
+			return nil, fmt.Errorf("curtail: %w", err)
+		}
+
+		curtailS = func(limit float64) error {
+			return limitS(int64(math.Round(limit)))
+		}
+	}
+
+	res := m.Decorate(totalEnergyG, currentsG, voltagesG, powersG, batterySocG, cc.capacity.Decorator(), curtailS)
 
 	return res, nil
 }
@@ -140,8 +155,9 @@ func (m *Meter) Decorate(
 	powers func() (float64, float64, float64, error),
 	batterySoc func() (float64, error),
 	capacity func() float64,
+	curtail func(float64) error,
 ) api.Meter {
-	return decorateMeter(m, totalEnergy, currents, voltages, powers, batterySoc, capacity)
+	return decorateMeter(m, totalEnergy, currents, voltages, powers, batterySoc, capacity, curtail)
 }
 
 // CurrentPower implements the api.Meter interface
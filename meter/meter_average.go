@@ -68,7 +68,13 @@ func NewMovingAverageFromConfig(other map[string]interface{}) (api.Meter, error)
 		powers = m.Powers
 	}
 
-	return meter.Decorate(totalEnergy, currents, voltages, powers, batterySoc, cc.Meter.capacity.Decorator()), nil
+	// decorate curtailment
+	var curtail func(float64) error
+	if m, ok := m.(api.Curtailer); ok {
+		curtail = m.Curtail
+	}
+
+	return meter.Decorate(totalEnergy, currents, voltages, powers, batterySoc, cc.Meter.capacity.Decorator(), curtail), nil
 }
 
 type MovingAverage struct {
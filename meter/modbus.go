@@ -3,8 +3,12 @@ package meter
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/andig/gosunspec/models/model120"
+	"github.com/andig/gosunspec/models/model121"
+	"github.com/andig/gosunspec/models/model123"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/modbus"
@@ -26,7 +30,7 @@ func init() {
 	registry.Add("modbus", NewModbusFromConfig)
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateModbus -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64"
+//go:generate go run ../cmd/tools/decorate.go -f decorateModbus -b api.Meter -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.PhasePowers,Powers,func() (float64, float64, float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.BatteryCapacity,Capacity,func() float64" -t "api.Curtailer,Curtail,func(limit float64) error"
 
 // NewModbusFromConfig creates api.Meter from config
 func NewModbusFromConfig(other map[string]interface{}) (api.Meter, error) {
@@ -99,6 +103,7 @@ func NewModbusFromConfig(other map[string]interface{}) (api.Meter, error) {
 	if err := modbus.ParseOperation(device, cc.Power, &m.opPower); err != nil {
 		return nil, fmt.Errorf("invalid measurement for power: %s", cc.Power)
 	}
+	conn.Schedule(m.opPower)
 
 	// decorate energy
 	var totalEnergy func() (float64, error)
@@ -106,6 +111,7 @@ func NewModbusFromConfig(other map[string]interface{}) (api.Meter, error) {
 		if err := modbus.ParseOperation(device, cc.Energy, &m.opEnergy); err != nil {
 			return nil, fmt.Errorf("invalid measurement for energy: %s", cc.Energy)
 		}
+		conn.Schedule(m.opEnergy)
 
 		totalEnergy = m.totalEnergy
 	}
@@ -134,11 +140,66 @@ func NewModbusFromConfig(other map[string]interface{}) (api.Meter, error) {
 		if err := modbus.ParseOperation(device, cc.Soc, &m.opSoc); err != nil {
 			return nil, fmt.Errorf("invalid measurement for soc: %s", cc.Soc)
 		}
+		conn.Schedule(m.opSoc)
 
 		soc = m.soc
 	}
 
-	return decorateModbus(m, totalEnergy, currentsG, voltagesG, powersG, soc, cc.capacity.Decorator()), nil
+	// decorate curtailment if inverter supports SunSpec immediate controls
+	var curtail func(float64) error
+	if dev, ok := device.(*sunspec.SunSpec); ok {
+		if wmax, err := m.ratedPower(dev); err == nil {
+			curtail = m.curtail(dev, wmax)
+		}
+	}
+
+	return decorateModbus(m, totalEnergy, currentsG, voltagesG, powersG, soc, cc.capacity.Decorator(), curtail), nil
+}
+
+// ratedPower returns the inverter's maximum power if model 123 controls are available
+func (m *Modbus) ratedPower(dev *sunspec.SunSpec) (float64, error) {
+	if _, _, err := dev.QueryPointAny(m.conn, model123.ModelID, 0, model123.WMaxLimPct); err != nil {
+		return 0, err
+	}
+
+	wmax, err := dev.QueryPoint(m.conn, model121.ModelID, 0, model121.WMax)
+	if err != nil || wmax <= 0 {
+		wmax, err = dev.QueryPoint(m.conn, model120.ModelID, 0, model120.WRtg)
+	}
+
+	if err == nil && wmax <= 0 {
+		err = errors.New("invalid rated power")
+	}
+
+	return wmax, err
+}
+
+// curtailRevert is the inverter's timeout for reverting a curtailment that is not renewed,
+// preventing a permanent limit if evcc stops unexpectedly
+const curtailRevert = 5 * time.Minute
+
+// curtail implements the api.Curtailer interface using SunSpec model 123 immediate controls
+func (m *Modbus) curtail(dev *sunspec.SunSpec, wmax float64) func(float64) error {
+	return func(limit float64) error {
+		block, _, err := dev.QueryPointAny(m.conn, model123.ModelID, 0, model123.WMaxLimPct)
+		if err != nil {
+			return err
+		}
+
+		if limit < 0 {
+			block.MustPoint(model123.WMaxLim_Ena).SetEnum16(0)
+			return block.Write(model123.WMaxLim_Ena)
+		}
+
+		pct := math.Min(100, limit/wmax*100)
+		sf := block.MustPoint(model123.WMaxLimPct_SF).ScaleFactor()
+
+		block.MustPoint(model123.WMaxLimPct).SetUint16(uint16(math.Round(pct / math.Pow10(int(sf)))))
+		block.MustPoint(model123.WMaxLimPct_RvrtTms).SetUint16(uint16(curtailRevert.Seconds()))
+		block.MustPoint(model123.WMaxLim_Ena).SetEnum16(1)
+
+		return block.Write(model123.WMaxLimPct, model123.WMaxLimPct_RvrtTms, model123.WMaxLim_Ena)
+	}
 }
 
 func (m *Modbus) buildPhaseProviders(readings []string) (func() (float64, float64, float64, error), error) {
@@ -155,6 +216,7 @@ func (m *Modbus) buildPhaseProviders(readings []string) (func() (float64, float6
 			if err := modbus.ParseOperation(m.device, reading, &opCurrent); err != nil {
 				return nil, fmt.Errorf("invalid measurement [%d]: %s", idx, reading)
 			}
+			m.conn.Schedule(opCurrent)
 
 			c := func() (float64, error) {
 				return m.floatGetter(opCurrent)
@@ -0,0 +1,52 @@
+package meter
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/plugin"
+)
+
+func init() {
+	registry.Add("plugin", NewPluginFromConfig)
+}
+
+// NewPluginFromConfig creates a meter provided by an out-of-process plugin
+func NewPluginFromConfig(other map[string]interface{}) (api.Meter, error) {
+	var cc struct {
+		capacity      `mapstructure:",squash"`
+		plugin.Config `mapstructure:",squash"`
+		Other         map[string]string `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	client, err := plugin.NewClient("meter", cc.Config, cc.Other)
+	if err != nil {
+		return nil, err
+	}
+
+	m, _ := NewConfigurable(client.FloatGetter(client.CurrentPower))
+
+	var totalEnergy, soc func() (float64, error)
+	if client.Has(plugin.MeterEnergy) {
+		totalEnergy = client.FloatGetter(client.TotalEnergy)
+	}
+	if client.Has(plugin.Battery) {
+		soc = client.FloatGetter(client.Soc)
+	}
+
+	var currents, voltages, powers func() (float64, float64, float64, error)
+	if client.Has(plugin.PhaseCurrents) {
+		currents = client.PhaseGetter(client.Currents)
+	}
+	if client.Has(plugin.PhaseVoltages) {
+		voltages = client.PhaseGetter(client.Voltages)
+	}
+	if client.Has(plugin.PhasePowers) {
+		powers = client.PhaseGetter(client.Powers)
+	}
+
+	return m.Decorate(totalEnergy, currents, voltages, powers, soc, cc.capacity.Decorator(), nil), nil
+}
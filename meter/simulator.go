@@ -0,0 +1,66 @@
+package meter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/util"
+)
+
+func init() {
+	registry.Add("simulator", NewSimulatorFromConfig)
+}
+
+// NewSimulatorFromConfig creates a simulated grid, pv or battery meter from generic config
+func NewSimulatorFromConfig(other map[string]interface{}) (api.Meter, error) {
+	cc := struct {
+		Model string // shared simulation model
+		Usage string // grid, pv or battery
+
+		// grid: home load, pv: generation
+		Peak    float64
+		Profile simulator.Profile
+		Noise   float64
+
+		// battery
+		Capacity, Soc, MinSoc, MaxPower float64
+	}{
+		Peak: 10000,
+		Soc:  50,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	m := simulator.Instance(cc.Model)
+
+	switch strings.ToLower(cc.Usage) {
+	case "grid":
+		m.SetLoad(&simulator.Load{Profile: cc.Profile, Noise: cc.Noise})
+
+		res, _ := NewConfigurable(m.GridPower)
+		return res.Decorate(m.GridEnergy, nil, nil, nil, nil, nil, nil), nil
+
+	case "pv":
+		m.SetGenerator(&simulator.Generator{Peak: cc.Peak, Profile: cc.Profile, Noise: cc.Noise})
+
+		res, _ := NewConfigurable(m.PVPower)
+		return res.Decorate(m.PVEnergy, nil, nil, nil, nil, nil, m.Curtail), nil
+
+	case "battery":
+		if cc.Capacity == 0 {
+			cc.Capacity = 10
+		}
+
+		m.SetBattery(&simulator.Battery{Capacity: cc.Capacity, Soc: cc.Soc, MinSoc: cc.MinSoc, MaxPower: cc.MaxPower})
+
+		res, _ := NewConfigurable(m.BatteryPower)
+		return res.Decorate(nil, nil, nil, nil, m.BatterySoc, m.BatteryCapacity, nil), nil
+
+	default:
+		return nil, fmt.Errorf("invalid usage: %s", cc.Usage)
+	}
+}
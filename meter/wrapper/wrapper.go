@@ -0,0 +1,61 @@
+package wrapper
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Wrapper wraps an api.Meter to capture initialization errors.
+// The meter is re-created in the background and swapped in once available.
+// Only the basic meter interface is delegated. Consumers pick up optional
+// meter features by replacing the wrapper with the live meter once available.
+type Wrapper struct {
+	mu     sync.RWMutex
+	meter  api.Meter
+	online func()
+	err    error
+}
+
+// New creates a new Meter that is re-created using factory until it succeeds.
+// The optional online callback is invoked once the meter has become available.
+func New(name string, err error, factory func() (api.Meter, error), online func()) *Wrapper {
+	m := &Wrapper{
+		online: online,
+		err:    fmt.Errorf("meter not available: %w", err),
+	}
+
+	util.Reinit(util.NewLogger(name), factory, m.setMeter)
+
+	return m
+}
+
+// setMeter swaps in the live meter
+func (m *Wrapper) setMeter(meter api.Meter) {
+	m.mu.Lock()
+	m.meter = meter
+	m.mu.Unlock()
+
+	if m.online != nil {
+		m.online()
+	}
+}
+
+// Live returns the live meter or nil if still offline
+func (m *Wrapper) Live() api.Meter {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.meter
+}
+
+var _ api.Meter = (*Wrapper)(nil)
+
+// CurrentPower implements the api.Meter interface
+func (m *Wrapper) CurrentPower() (float64, error) {
+	if meter := m.Live(); meter != nil {
+		return meter.CurrentPower()
+	}
+	return 0, m.err
+}
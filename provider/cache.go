@@ -26,6 +26,11 @@ func ResetCached() {
 	bus.Publish(reset)
 }
 
+// OnResetCached registers a function to be called when all caches are reset
+func OnResetCached(fn func()) {
+	_ = bus.Subscribe(reset, fn)
+}
+
 // cached wraps a getter with a cache
 type cached[T any] struct {
 	mux            sync.Mutex
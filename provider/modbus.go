@@ -127,6 +127,9 @@ func NewModbusFromConfig(other map[string]interface{}) (IntProvider, error) {
 		}
 	}
 
+	// coalesce register reads with other devices on the same connection
+	conn.Schedule(op)
+
 	mb := &Modbus{
 		log:    log,
 		conn:   conn,
@@ -11,6 +11,7 @@ import (
 
 	paho "github.com/eclipse/paho.mqtt.golang"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/trace"
 )
 
 const (
@@ -93,14 +94,19 @@ func NewClient(log *util.Logger, broker, user, password, clientID string, qos by
 	if len(or.Servers()) == 1 {
 		mc.broker = or.Servers()[0].String()
 	}
+	mc.Client = client
+
+	// replayed messages don't require a broker
+	if trace.Replaying() {
+		return mc, nil
+	}
+
 	log.INFO.Printf("connecting %s at %s", clientID, mc.broker)
 
 	if token := client.Connect(); token.Wait() && token.Error() != nil {
 		return nil, fmt.Errorf("error connecting: %w", token.Error())
 	}
 
-	mc.Client = client
-
 	return mc, nil
 }
 
@@ -125,6 +131,12 @@ func (m *Client) ConnectionHandler(client paho.Client) {
 // Publish synchronously publishes payload using client qos
 func (m *Client) Publish(topic string, retained bool, payload interface{}) error {
 	m.log.TRACE.Printf("send %s: '%v'", topic, payload)
+	trace.MqttPublish(topic, payload)
+
+	if trace.Replaying() {
+		return nil
+	}
+
 	token := m.Client.Publish(topic, m.Qos, retained, payload)
 	if token.WaitTimeout(publishTimeout) {
 		return token.Error()
@@ -153,22 +165,36 @@ func (m *Client) ListenSetter(topic string, callback func(string)) {
 
 // listen attaches listener to topic
 func (m *Client) listen(topic string) {
+	// deliver replayed messages instead of subscribing
+	if trace.Replaying() {
+		trace.MqttSubscribe(topic, func(payload string) {
+			m.receive(topic, payload)
+		})
+		return
+	}
+
 	token := m.Client.Subscribe(topic, m.Qos, func(c paho.Client, msg paho.Message) {
 		payload := string(msg.Payload())
-		m.log.TRACE.Printf("recv %s: '%v'", topic, payload)
-		if len(payload) > 0 {
-			m.mux.Lock()
-			callbacks := m.listener[topic]
-			m.mux.Unlock()
-
-			for _, cb := range callbacks {
-				cb(payload)
-			}
-		}
+		trace.MqttReceive(topic, payload)
+		m.receive(topic, payload)
 	})
 	m.WaitForToken(token)
 }
 
+// receive passes the payload to the topic's listeners
+func (m *Client) receive(topic, payload string) {
+	m.log.TRACE.Printf("recv %s: '%v'", topic, payload)
+	if len(payload) > 0 {
+		m.mux.Lock()
+		callbacks := m.listener[topic]
+		m.mux.Unlock()
+
+		for _, cb := range callbacks {
+			cb(payload)
+		}
+	}
+}
+
 // WaitForToken synchronously waits until token operation completed
 func (m *Client) WaitForToken(token paho.Token) {
 	if token.WaitTimeout(publishTimeout) {
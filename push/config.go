@@ -10,6 +10,12 @@ type Messenger interface {
 	Send(title, msg string)
 }
 
+// EventSender implements structured event sending. Senders implementing
+// EventSender receive all events including a snapshot of the current values.
+type EventSender interface {
+	SendEvent(ev Event, data map[string]interface{})
+}
+
 type senderRegistry map[string]func(map[string]interface{}) (Messenger, error)
 
 func (r senderRegistry) Add(name string, factory func(map[string]interface{}) (Messenger, error)) {
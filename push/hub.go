@@ -6,13 +6,16 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/util"
+	"golang.org/x/exp/slices"
 )
 
 // Event is a notification event
 type Event struct {
-	Loadpoint *int // optional loadpoint id
-	Event     string
+	Loadpoint  *int // optional loadpoint id
+	Event      string
+	Attributes map[string]interface{} // optional event attributes
 }
 
 // EventTemplateConfig is the push message configuration for an event
@@ -20,10 +23,13 @@ type EventTemplateConfig struct {
 	Title, Msg string
 }
 
+// Instance is the configured push hub
+var Instance *Hub
+
 // Hub subscribes to event notifications and sends them to client devices
 type Hub struct {
 	definitions map[string]EventTemplateConfig
-	sender      []Messenger
+	recipients  []*Recipient
 	cache       *util.Cache
 }
 
@@ -39,21 +45,41 @@ func NewHub(cc map[string]EventTemplateConfig, cache *util.Cache) (*Hub, error)
 		}
 	}
 
+	// default recipient receives all events
+	all, _ := NewRecipient(RecipientConfig{})
+
 	h := &Hub{
 		definitions: cc,
+		recipients:  []*Recipient{all},
 		cache:       cache,
 	}
 
 	return h, nil
 }
 
-// Add adds a sender to the list of senders
+// Add adds a sender to the default recipient receiving all events
 func (h *Hub) Add(sender Messenger) {
-	h.sender = append(h.sender, sender)
+	h.recipients[0].Add(sender)
 }
 
-// apply applies the event template to the content to produce the actual message
-func (h *Hub) apply(ev Event, tmpl string) (string, error) {
+// AddRecipient adds a recipient with individual routing
+func (h *Hub) AddRecipient(r *Recipient) {
+	h.recipients = append(h.recipients, r)
+}
+
+// SiteControl passes the site to all senders implementing site.Controller
+func (h *Hub) SiteControl(api site.API) {
+	for _, r := range h.recipients {
+		for _, sender := range r.sender {
+			if ctrl, ok := sender.(site.Controller); ok {
+				ctrl.SiteControl(api)
+			}
+		}
+	}
+}
+
+// attributes returns the site's and event loadpoint's values from the cache
+func (h *Hub) attributes(ev Event) map[string]interface{} {
 	attr := make(map[string]interface{})
 
 	// loadpoint id
@@ -63,25 +89,57 @@ func (h *Hub) apply(ev Event, tmpl string) (string, error) {
 
 	// get all values from cache
 	for _, p := range h.cache.All() {
-		if p.Loadpoint == nil || ev.Loadpoint == p.Loadpoint {
+		if p.Loadpoint == nil || ev.Loadpoint != nil && *ev.Loadpoint == *p.Loadpoint {
 			attr[p.Key] = p.Val
 		}
 	}
 
+	// event attributes take precedence
+	for k, v := range ev.Attributes {
+		attr[k] = v
+	}
+
+	return attr
+}
+
+// apply applies the event template to the content to produce the actual message
+func (h *Hub) apply(attr map[string]interface{}, tmpl string) (string, error) {
 	return util.ReplaceFormatted(tmpl, attr)
 }
 
+// Test sends a test message to all messengers of the given recipient or to all recipients if name is empty.
+// Routing, quiet hours and rate limits are ignored. It returns the number of messengers.
+func (h *Hub) Test(name, title, msg string) (int, error) {
+	var count int
+	var found bool
+
+	for _, r := range h.recipients {
+		if name != "" && !strings.EqualFold(r.Name, name) {
+			continue
+		}
+
+		found = true
+
+		for _, sender := range r.sender {
+			go sender.Send(title, msg)
+			count++
+		}
+	}
+
+	if !found {
+		return 0, fmt.Errorf("recipient not found: %s", name)
+	}
+
+	return count, nil
+}
+
 // Run is the Hub's main publishing loop
 func (h *Hub) Run(events <-chan Event, valueChan chan util.Param) {
 	log := util.NewLogger("push")
 
 	for ev := range events {
-		if len(h.sender) == 0 {
-			continue
-		}
-
 		definition, ok := h.definitions[ev.Event]
-		if !ok {
+		if !ok && !h.hasEventSender() {
 			continue
 		}
 
@@ -90,24 +148,49 @@ func (h *Hub) Run(events <-chan Event, valueChan chan util.Param) {
 		valueChan <- util.Param{Val: flushC}
 		<-flushC
 
-		title, err := h.apply(ev, definition.Title)
-		if err != nil {
-			log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
-			continue
+		attr := h.attributes(ev)
+
+		var title, msg string
+		if ok {
+			var err error
+			if title, err = h.apply(attr, definition.Title); err != nil {
+				log.ERROR.Printf("invalid title template for %s: %v", ev.Event, err)
+				ok = false
+			} else if msg, err = h.apply(attr, definition.Msg); err != nil {
+				log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
+				ok = false
+			} else if strings.TrimSpace(msg) == "" {
+				log.DEBUG.Printf("did not send empty message template for %s", ev.Event)
+				ok = false
+			}
 		}
 
-		msg, err := h.apply(ev, definition.Msg)
-		if err != nil {
-			log.ERROR.Printf("invalid message template for %s: %v", ev.Event, err)
-			continue
-		}
+		for _, r := range h.recipients {
+			// nothing to send
+			if !ok && !r.hasEventSender() {
+				continue
+			}
 
-		for _, sender := range h.sender {
-			if strings.TrimSpace(msg) != "" {
-				go sender.Send(title, msg)
-			} else {
-				log.DEBUG.Printf("did not send empty message template for %s: %v", ev.Event, err)
+			if accept, reason := r.accept(ev, attr); !accept {
+				if r.Name != "" {
+					log.DEBUG.Printf("%s: skipped %s (%s)", r.Name, ev.Event, reason)
+				}
+				continue
+			}
+
+			for _, sender := range r.sender {
+				// structured events don't require a template
+				if es, isEventSender := sender.(EventSender); isEventSender {
+					go es.SendEvent(ev, attr)
+				} else if ok {
+					go sender.Send(title, msg)
+				}
 			}
 		}
 	}
 }
+
+// hasEventSender checks if any sender accepts structured events
+func (h *Hub) hasEventSender() bool {
+	return slices.ContainsFunc(h.recipients, (*Recipient).hasEventSender)
+}
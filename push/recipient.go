@@ -0,0 +1,144 @@
+package push
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"golang.org/x/exp/slices"
+)
+
+// RecipientConfig is the routing configuration of a recipient
+type RecipientConfig struct {
+	Name       string
+	Events     []string      // events to send, default all
+	Loadpoints []int         // loadpoint ids (1-based), default all
+	Vehicles   []string      // vehicle titles, default all
+	Quiet      string        // quiet hours like 22:00-07:00
+	RateLimit  time.Duration // min interval between identical events
+}
+
+// Recipient routes events to its messengers
+type Recipient struct {
+	Name       string
+	clock      clock.Clock
+	sender     []Messenger
+	events     []string
+	loadpoints []int
+	vehicles   []string
+	quietFrom  int // minutes of day
+	quietTo    int // minutes of day
+	rateLimit  time.Duration
+	last       map[string]time.Time
+}
+
+// NewRecipient creates a recipient from configuration
+func NewRecipient(cc RecipientConfig) (*Recipient, error) {
+	r := &Recipient{
+		Name:       cc.Name,
+		clock:      clock.New(),
+		events:     cc.Events,
+		loadpoints: cc.Loadpoints,
+		vehicles:   cc.Vehicles,
+		rateLimit:  cc.RateLimit,
+		last:       make(map[string]time.Time),
+	}
+
+	if cc.Quiet != "" {
+		from, to, ok := strings.Cut(cc.Quiet, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid quiet hours: %s", cc.Quiet)
+		}
+
+		var err error
+		if r.quietFrom, err = minutesOfDay(from); err == nil {
+			r.quietTo, err = minutesOfDay(to)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid quiet hours: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+// minutesOfDay parses hh:mm into minutes since midnight
+func minutesOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return 60*t.Hour() + t.Minute(), nil
+}
+
+// Add adds a sender to the recipient
+func (r *Recipient) Add(sender Messenger) {
+	r.sender = append(r.sender, sender)
+}
+
+// hasEventSender checks if any sender accepts structured events
+func (r *Recipient) hasEventSender() bool {
+	for _, sender := range r.sender {
+		if _, ok := sender.(EventSender); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// quiet checks if ts is within the recipient's quiet hours
+func (r *Recipient) quiet(ts time.Time) bool {
+	if r.quietFrom == r.quietTo {
+		return false
+	}
+
+	min := 60*ts.Hour() + ts.Minute()
+	if r.quietFrom < r.quietTo {
+		return min >= r.quietFrom && min < r.quietTo
+	}
+
+	// wrap around midnight
+	return min >= r.quietFrom || min < r.quietTo
+}
+
+// accept checks if the event should be sent to the recipient. Accepted events are rate limited.
+func (r *Recipient) accept(ev Event, attr map[string]interface{}) (bool, string) {
+	if len(r.events) > 0 && !slices.Contains(r.events, ev.Event) {
+		return false, "event"
+	}
+
+	if len(r.loadpoints) > 0 && (ev.Loadpoint == nil || !slices.Contains(r.loadpoints, *ev.Loadpoint+1)) {
+		return false, "loadpoint"
+	}
+
+	if len(r.vehicles) > 0 {
+		title, _ := attr["vehicleTitle"].(string)
+		if !slices.ContainsFunc(r.vehicles, func(v string) bool {
+			return strings.EqualFold(v, title)
+		}) {
+			return false, "vehicle"
+		}
+	}
+
+	now := r.clock.Now()
+
+	if r.quiet(now) {
+		return false, "quiet hours"
+	}
+
+	if r.rateLimit > 0 {
+		key := ev.Event
+		if ev.Loadpoint != nil {
+			key = fmt.Sprintf("%s.%d", key, *ev.Loadpoint)
+		}
+
+		if last, ok := r.last[key]; ok && now.Sub(last) < r.rateLimit {
+			return false, "rate limit"
+		}
+
+		r.last[key] = now
+	}
+
+	return true, ""
+}
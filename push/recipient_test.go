@@ -0,0 +1,74 @@
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipientFilter(t *testing.T) {
+	r, err := NewRecipient(RecipientConfig{
+		Events:     []string{"stop"},
+		Loadpoints: []int{2},
+		Vehicles:   []string{"e-Golf"},
+	})
+	require.NoError(t, err)
+
+	lp1, lp2 := 0, 1
+	attr := map[string]interface{}{"vehicleTitle": "e-golf"}
+
+	for _, tc := range []struct {
+		ev     Event
+		attr   map[string]interface{}
+		accept bool
+	}{
+		{Event{Event: "start", Loadpoint: &lp2}, attr, false},
+		{Event{Event: "stop"}, attr, false},
+		{Event{Event: "stop", Loadpoint: &lp1}, attr, false},
+		{Event{Event: "stop", Loadpoint: &lp2}, nil, false},
+		{Event{Event: "stop", Loadpoint: &lp2}, attr, true},
+	} {
+		ok, _ := r.accept(tc.ev, tc.attr)
+		assert.Equal(t, tc.accept, ok, tc)
+	}
+}
+
+func TestRecipientQuietHoursAndRateLimit(t *testing.T) {
+	r, err := NewRecipient(RecipientConfig{
+		Quiet:     "22:00-07:00",
+		RateLimit: time.Hour,
+	})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	clock.Set(time.Date(2023, 1, 1, 23, 0, 0, 0, time.Local))
+	r.clock = clock
+
+	ev := Event{Event: "stop"}
+
+	ok, reason := r.accept(ev, nil)
+	assert.False(t, ok)
+	assert.Equal(t, "quiet hours", reason)
+
+	clock.Add(8 * time.Hour) // 07:00
+	ok, _ = r.accept(ev, nil)
+	assert.True(t, ok)
+
+	clock.Add(30 * time.Minute)
+	ok, reason = r.accept(ev, nil)
+	assert.False(t, ok)
+	assert.Equal(t, "rate limit", reason)
+
+	ok, _ = r.accept(Event{Event: "start"}, nil)
+	assert.True(t, ok)
+
+	clock.Add(30 * time.Minute)
+	ok, _ = r.accept(ev, nil)
+	assert.True(t, ok)
+
+	_, err = NewRecipient(RecipientConfig{Quiet: "22:00"})
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/util"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
@@ -19,13 +20,24 @@ type Telegram struct {
 	sync.Mutex
 	bot   *tgbotapi.BotAPI
 	chats map[int64]struct{}
+
+	// bot control
+	site     site.API
+	readonly map[int64]struct{}
+	control  map[int64]struct{}
 }
 
+var _ site.Controller = (*Telegram)(nil)
+
 // NewTelegramFromConfig creates new pushover messenger
 func NewTelegramFromConfig(other map[string]interface{}) (Messenger, error) {
 	var cc struct {
 		Token string
 		Chats []int64
+		Bot   struct {
+			Readonly []int64 // chats allowed to query status
+			Control  []int64 // chats allowed to control loadpoints
+		}
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -40,36 +52,70 @@ func NewTelegramFromConfig(other map[string]interface{}) (Messenger, error) {
 	log := util.NewLogger("telegram").Redact(cc.Token)
 	_ = tgbotapi.SetLogger(log.ERROR)
 
-	for _, i := range cc.Chats {
-		log.Redact(strconv.FormatInt(i, 10))
+	for _, ids := range [][]int64{cc.Chats, cc.Bot.Readonly, cc.Bot.Control} {
+		for _, i := range ids {
+			log.Redact(strconv.FormatInt(i, 10))
+		}
 	}
 
 	m := &Telegram{
-		log:   log,
-		bot:   bot,
-		chats: make(map[int64]struct{}),
+		log:      log,
+		bot:      bot,
+		chats:    make(map[int64]struct{}),
+		readonly: make(map[int64]struct{}),
+		control:  make(map[int64]struct{}),
 	}
 
 	for _, chat := range cc.Chats {
 		m.chats[chat] = struct{}{}
 	}
 
+	for _, chat := range cc.Bot.Readonly {
+		m.readonly[chat] = struct{}{}
+	}
+
+	for _, chat := range cc.Bot.Control {
+		m.control[chat] = struct{}{}
+	}
+
 	go m.trackChats()
 
 	return m, nil
 }
 
-// trackChats captures ids of all chats that bot participates in
+// SiteControl implements the site.Controller interface
+func (m *Telegram) SiteControl(site site.API) {
+	m.Lock()
+	m.site = site
+	m.Unlock()
+}
+
+// trackChats captures ids of all chats that bot participates in and handles bot commands
 func (m *Telegram) trackChats() {
 	conf := tgbotapi.NewUpdate(0)
 	conf.Timeout = 1000
 
 	for update := range m.bot.GetUpdatesChan(conf) {
+		if update.CallbackQuery != nil {
+			m.handleCallback(update.CallbackQuery)
+			continue
+		}
+
+		if update.Message == nil {
+			continue
+		}
+
 		m.Lock()
-		if _, ok := m.chats[update.Message.Chat.ID]; !ok {
+		_, known := m.chats[update.Message.Chat.ID]
+		m.Unlock()
+
+		if !known && !m.allowed(update.Message.Chat.ID, false) {
 			m.log.INFO.Printf("new chat id: %d", update.Message.Chat.ID)
 		}
-		m.Unlock()
+
+		if update.Message.IsCommand() {
+			m.handleCommand(update.Message)
+		}
 	}
 }
 
@@ -0,0 +1,323 @@
+package push
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+const telegramHelp = `/status - loadpoint status
+/mode <lp> <off|now|minpv|pv> - set charge mode
+/soc <lp> <soc> - set target soc
+/time <lp> <hh:mm|off> - set target time
+/vehicle <lp> <vehicle|off> - select vehicle`
+
+// botReply is a bot response with optional inline keyboard
+type botReply struct {
+	text     string
+	keyboard [][]tgbotapi.InlineKeyboardButton
+}
+
+// allowed checks if the chat may use read-only or control commands
+func (m *Telegram) allowed(chat int64, control bool) bool {
+	if _, ok := m.control[chat]; ok {
+		return true
+	}
+	_, ok := m.readonly[chat]
+	return ok && !control
+}
+
+// handleCommand executes a bot command message
+func (m *Telegram) handleCommand(msg *tgbotapi.Message) {
+	chat := msg.Chat.ID
+	if !m.allowed(chat, false) {
+		return
+	}
+
+	reply := m.execute(chat, msg.Command(), strings.Fields(msg.CommandArguments()))
+
+	res := tgbotapi.NewMessage(chat, reply.text)
+	if len(reply.keyboard) > 0 {
+		res.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(reply.keyboard...)
+	}
+
+	if _, err := m.bot.Send(res); err != nil {
+		m.log.ERROR.Println("send:", err)
+	}
+}
+
+// handleCallback executes an inline keyboard selection
+func (m *Telegram) handleCallback(cb *tgbotapi.CallbackQuery) {
+	if cb.Message == nil || !m.allowed(cb.Message.Chat.ID, false) {
+		return
+	}
+
+	chat := cb.Message.Chat.ID
+
+	var reply botReply
+	if fields := strings.Fields(cb.Data); len(fields) > 0 {
+		reply = m.execute(chat, fields[0], fields[1:])
+	}
+
+	if _, err := m.bot.Request(tgbotapi.NewCallback(cb.ID, "")); err != nil {
+		m.log.ERROR.Println("callback:", err)
+	}
+
+	var res tgbotapi.EditMessageTextConfig
+	if len(reply.keyboard) > 0 {
+		res = tgbotapi.NewEditMessageTextAndMarkup(chat, cb.Message.MessageID, reply.text, tgbotapi.NewInlineKeyboardMarkup(reply.keyboard...))
+	} else {
+		res = tgbotapi.NewEditMessageText(chat, cb.Message.MessageID, reply.text)
+	}
+
+	if _, err := m.bot.Send(res); err != nil {
+		m.log.ERROR.Println("send:", err)
+	}
+}
+
+// execute runs a bot command on behalf of the chat
+func (m *Telegram) execute(chat int64, cmd string, args []string) botReply {
+	m.Lock()
+	site := m.site
+	m.Unlock()
+
+	if site == nil {
+		return botReply{text: "not ready"}
+	}
+
+	if cmd == "help" || cmd == "start" {
+		return botReply{text: telegramHelp}
+	}
+
+	if cmd == "status" {
+		return botReply{text: botStatus(site)}
+	}
+
+	if !m.allowed(chat, true) {
+		return botReply{text: "not allowed"}
+	}
+
+	lps := site.Loadpoints()
+
+	// loadpoint id may be omitted for single loadpoint
+	if len(lps) == 1 {
+		if _, err := strconv.Atoi(firstOrEmpty(args)); err != nil || len(args) == 1 && args[0] != "1" {
+			args = append([]string{"1"}, args...)
+		}
+	}
+
+	switch cmd {
+	case "mode", "soc", "time", "vehicle":
+	default:
+		return botReply{text: "unknown command\n" + telegramHelp}
+	}
+
+	if len(args) == 0 {
+		var row []tgbotapi.InlineKeyboardButton
+		for id, lp := range lps {
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(lp.Title(), fmt.Sprintf("%s %d", cmd, id+1)))
+		}
+		return botReply{text: "select loadpoint", keyboard: [][]tgbotapi.InlineKeyboardButton{row}}
+	}
+
+	id, err := strconv.Atoi(args[0])
+	if err != nil || id < 1 || id > len(lps) {
+		return botReply{text: fmt.Sprintf("invalid loadpoint: %s", args[0])}
+	}
+
+	lp := lps[id-1]
+	args = args[1:]
+
+	switch cmd {
+	case "mode":
+		return botMode(lp, id, args)
+	case "soc":
+		return botSoc(lp, id, args)
+	case "time":
+		return botTime(lp, args)
+	default:
+		return botVehicle(site, lp, id, args)
+	}
+}
+
+func firstOrEmpty(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// botStatus summarizes all loadpoints
+func botStatus(site site.API) string {
+	var b strings.Builder
+
+	for id, lp := range site.Loadpoints() {
+		if id > 0 {
+			b.WriteString("\n\n")
+		}
+
+		fmt.Fprintf(&b, "%d %s: %s, mode %s", id+1, lp.Title(), botChargeStatus(lp.GetStatus()), lp.GetMode())
+
+		if power := lp.GetChargePower(); power > 0 {
+			fmt.Fprintf(&b, ", %.1fkW", power/1e3)
+		}
+
+		if v := lp.GetVehicle(); v != nil {
+			fmt.Fprintf(&b, "\nvehicle: %s", v.Title())
+		}
+
+		if soc := lp.GetTargetSoc(); soc > 0 {
+			fmt.Fprintf(&b, "\ntarget soc: %d%%", soc)
+		}
+
+		if ts := lp.GetTargetTime(); !ts.IsZero() {
+			fmt.Fprintf(&b, "\ntarget time: %s", ts.Local().Format("Mon 15:04"))
+		}
+
+		if d := lp.GetRemainingDuration(); d > 0 {
+			fmt.Fprintf(&b, "\nremaining: %v", d.Round(time.Minute))
+		}
+	}
+
+	return b.String()
+}
+
+func botChargeStatus(status api.ChargeStatus) string {
+	switch status {
+	case api.StatusA:
+		return "disconnected"
+	case api.StatusB:
+		return "connected"
+	case api.StatusC:
+		return "charging"
+	default:
+		return "unknown"
+	}
+}
+
+func botMode(lp loadpoint.API, id int, args []string) botReply {
+	if len(args) == 0 {
+		var row []tgbotapi.InlineKeyboardButton
+		for _, mode := range []api.ChargeMode{api.ModeOff, api.ModeNow, api.ModeMinPV, api.ModePV} {
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(string(mode), fmt.Sprintf("mode %d %s", id, mode)))
+		}
+		return botReply{text: "select mode", keyboard: [][]tgbotapi.InlineKeyboardButton{row}}
+	}
+
+	mode, err := api.ChargeModeString(args[0])
+	if err != nil || mode == api.ModeEmpty {
+		return botReply{text: fmt.Sprintf("invalid mode: %s", args[0])}
+	}
+
+	lp.SetMode(mode)
+
+	return botReply{text: fmt.Sprintf("%s: mode %s", lp.Title(), lp.GetMode())}
+}
+
+func botSoc(lp loadpoint.API, id int, args []string) botReply {
+	if len(args) == 0 {
+		var row []tgbotapi.InlineKeyboardButton
+		for soc := 50; soc <= 100; soc += 10 {
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("%d%%", soc), fmt.Sprintf("soc %d %d", id, soc)))
+		}
+		return botReply{text: "select target soc", keyboard: [][]tgbotapi.InlineKeyboardButton{row}}
+	}
+
+	soc, err := strconv.Atoi(strings.TrimSuffix(args[0], "%"))
+	if err != nil || soc < 0 || soc > 100 {
+		return botReply{text: fmt.Sprintf("invalid soc: %s", args[0])}
+	}
+
+	lp.SetTargetSoc(soc)
+
+	return botReply{text: fmt.Sprintf("%s: target soc %d%%", lp.Title(), lp.GetTargetSoc())}
+}
+
+// botTargetTime parses hh:mm as next occurrence or an RFC3339 timestamp
+func botTargetTime(s string, now time.Time) (time.Time, error) {
+	if s == "off" {
+		return time.Time{}, nil
+	}
+
+	if ts, err := time.Parse(time.RFC3339, s); err == nil {
+		return ts, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ts := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if !ts.After(now) {
+		ts = ts.AddDate(0, 0, 1)
+	}
+
+	return ts, nil
+}
+
+func botTime(lp loadpoint.API, args []string) botReply {
+	if len(args) == 0 {
+		return botReply{text: "missing time, use hh:mm or off"}
+	}
+
+	ts, err := botTargetTime(args[0], time.Now())
+	if err == nil {
+		err = lp.SetTargetTime(ts)
+	}
+	if err != nil {
+		return botReply{text: fmt.Sprintf("invalid time: %v", err)}
+	}
+
+	if ts.IsZero() {
+		return botReply{text: fmt.Sprintf("%s: target time removed", lp.Title())}
+	}
+
+	return botReply{text: fmt.Sprintf("%s: target time %s", lp.Title(), ts.Local().Format("Mon 15:04"))}
+}
+
+func botVehicle(site site.API, lp loadpoint.API, id int, args []string) botReply {
+	vehicles := site.GetVehicles()
+
+	if len(args) == 0 {
+		var rows [][]tgbotapi.InlineKeyboardButton
+		for i, v := range vehicles {
+			rows = append(rows, tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData(v.Title(), fmt.Sprintf("vehicle %d %d", id, i+1))))
+		}
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData("none", fmt.Sprintf("vehicle %d off", id))))
+		return botReply{text: "select vehicle", keyboard: rows}
+	}
+
+	if args[0] == "off" {
+		lp.SetVehicle(nil)
+		return botReply{text: fmt.Sprintf("%s: vehicle removed", lp.Title())}
+	}
+
+	var vehicle api.Vehicle
+
+	if i, err := strconv.Atoi(args[0]); err == nil && i >= 1 && i <= len(vehicles) {
+		vehicle = vehicles[i-1]
+	} else {
+		title := strings.Join(args, " ")
+		for _, v := range vehicles {
+			if strings.EqualFold(v.Title(), title) {
+				vehicle = v
+				break
+			}
+		}
+	}
+
+	if vehicle == nil {
+		return botReply{text: fmt.Sprintf("invalid vehicle: %s", strings.Join(args, " "))}
+	}
+
+	lp.SetVehicle(vehicle)
+
+	return botReply{text: fmt.Sprintf("%s: vehicle %s", lp.Title(), vehicle.Title())}
+}
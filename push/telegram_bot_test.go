@@ -0,0 +1,76 @@
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type botSite struct {
+	site.API
+	loadpoints []loadpoint.API
+}
+
+func (s *botSite) Loadpoints() []loadpoint.API {
+	return s.loadpoints
+}
+
+func TestTelegramBotPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().Title().Return("Garage").AnyTimes()
+
+	m := &Telegram{
+		site:     &botSite{loadpoints: []loadpoint.API{lp}},
+		readonly: map[int64]struct{}{1: {}},
+		control:  map[int64]struct{}{2: {}},
+	}
+
+	assert.True(t, m.allowed(1, false))
+	assert.False(t, m.allowed(1, true))
+	assert.True(t, m.allowed(2, true))
+	assert.False(t, m.allowed(3, false))
+
+	assert.Equal(t, "not allowed", m.execute(1, "mode", []string{"pv"}).text)
+
+	// missing mode shows keyboard
+	res := m.execute(2, "mode", nil)
+	assert.Len(t, res.keyboard, 1)
+	assert.Equal(t, "mode 1 pv", *res.keyboard[0][3].CallbackData)
+
+	// loadpoint id is optional for single loadpoint
+	lp.EXPECT().SetMode(api.ModePV)
+	lp.EXPECT().GetMode().Return(api.ModePV)
+	assert.Equal(t, "Garage: mode pv", m.execute(2, "mode", []string{"pv"}).text)
+
+	lp.EXPECT().SetTargetSoc(80)
+	lp.EXPECT().GetTargetSoc().Return(80)
+	assert.Equal(t, "Garage: target soc 80%", m.execute(2, "soc", []string{"1", "80"}).text)
+
+	assert.Equal(t, "invalid loadpoint: 2", m.execute(2, "soc", []string{"2", "80"}).text)
+}
+
+func TestTelegramBotTargetTime(t *testing.T) {
+	now := time.Date(2023, 1, 1, 18, 0, 0, 0, time.UTC)
+
+	ts, err := botTargetTime("07:30", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 2, 7, 30, 0, 0, time.UTC), ts)
+
+	ts, err = botTargetTime("20:00", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 1, 20, 0, 0, 0, time.UTC), ts)
+
+	ts, err = botTargetTime("off", now)
+	assert.NoError(t, err)
+	assert.True(t, ts.IsZero())
+
+	_, err = botTargetTime("tomorrow", now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,300 @@
+package push
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v3"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"github.com/google/uuid"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/exp/slices"
+)
+
+func init() {
+	registry.Add("webhook", NewWebhookFromConfig)
+}
+
+const (
+	webhookSignatureHeader = "X-Evcc-Signature"
+	webhookEventHeader     = "X-Evcc-Event"
+	webhookDeliveryHeader  = "X-Evcc-Delivery"
+
+	webhookOutboxInterval = time.Minute
+	webhookOutboxLimit    = 1000
+)
+
+// WebhookPayload is the webhook request body
+type WebhookPayload struct {
+	ID        string                 `json:"id"`
+	Event     string                 `json:"event"`
+	Loadpoint *int                   `json:"loadpoint,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+	Title     string                 `json:"title,omitempty"`
+	Msg       string                 `json:"msg,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// Webhook implements the webhook messenger posting structured events
+type Webhook struct {
+	*request.Helper
+	log     *util.Logger
+	uri     string
+	secret  []byte
+	events  []string
+	retries uint
+	delay   time.Duration
+
+	mu     sync.Mutex
+	file   string
+	outbox []WebhookPayload
+}
+
+var _ EventSender = (*Webhook)(nil)
+
+// NewWebhookFromConfig creates new webhook messenger
+func NewWebhookFromConfig(other map[string]interface{}) (Messenger, error) {
+	cc := struct {
+		URI     string
+		Secret  string
+		Events  []string
+		Retries uint
+		Outbox  string
+		Timeout time.Duration
+	}{
+		Retries: 5,
+		Timeout: request.Timeout,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.URI == "" {
+		return nil, errors.New("missing uri")
+	}
+
+	log := util.NewLogger("webhook").Redact(cc.Secret)
+
+	m := &Webhook{
+		Helper:  request.NewHelper(log),
+		log:     log,
+		uri:     cc.URI,
+		secret:  []byte(cc.Secret),
+		events:  cc.Events,
+		retries: cc.Retries,
+		delay:   time.Second,
+	}
+
+	m.Client.Timeout = cc.Timeout
+
+	if cc.Outbox != "" {
+		var err error
+		if m.file, err = homedir.Expand(cc.Outbox); err != nil {
+			return nil, err
+		}
+
+		if err := m.restore(); err != nil {
+			return nil, err
+		}
+
+		go m.run()
+	}
+
+	return m, nil
+}
+
+// Send implements the Messenger interface
+func (m *Webhook) Send(title, msg string) {
+	m.deliver(WebhookPayload{
+		ID:        uuid.NewString(),
+		Event:     "message",
+		Timestamp: time.Now(),
+		Title:     title,
+		Msg:       msg,
+	})
+}
+
+// SendEvent implements the EventSender interface
+func (m *Webhook) SendEvent(ev Event, data map[string]interface{}) {
+	if len(m.events) > 0 && !slices.Contains(m.events, ev.Event) {
+		return
+	}
+
+	payload := WebhookPayload{
+		ID:        uuid.NewString(),
+		Event:     ev.Event,
+		Timestamp: time.Now(),
+		Data:      data,
+	}
+
+	if ev.Loadpoint != nil {
+		id := *ev.Loadpoint + 1
+		payload.Loadpoint = &id
+	}
+
+	m.deliver(payload)
+}
+
+// signature returns the hex encoded HMAC-SHA256 signature of the body
+func (m *Webhook) signature(body []byte) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends a single payload
+func (m *Webhook) post(payload WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return retry.Unrecoverable(err)
+	}
+
+	headers := map[string]string{
+		"Content-Type":        request.JSONContent,
+		webhookEventHeader:    payload.Event,
+		webhookDeliveryHeader: payload.ID,
+	}
+
+	if len(m.secret) > 0 {
+		headers[webhookSignatureHeader] = m.signature(body)
+	}
+
+	req, err := request.New(http.MethodPost, m.uri, bytes.NewReader(body), headers)
+	if err == nil {
+		_, err = m.DoBody(req)
+	}
+
+	return err
+}
+
+// deliver sends a payload with retries and stores it in the outbox if delivery fails
+func (m *Webhook) deliver(payload WebhookPayload) {
+	err := retry.Do(func() error {
+		return m.post(payload)
+	}, retry.Attempts(m.retries+1), retry.Delay(m.delay), retry.LastErrorOnly(true))
+
+	if err == nil {
+		return
+	}
+
+	m.log.ERROR.Printf("%s: %v", payload.Event, err)
+
+	if m.file != "" {
+		m.mu.Lock()
+		m.outbox = append(m.outbox, payload)
+		if len(m.outbox) > webhookOutboxLimit {
+			m.outbox = m.outbox[len(m.outbox)-webhookOutboxLimit:]
+		}
+		m.mu.Unlock()
+
+		if err := m.persist(); err != nil {
+			m.log.ERROR.Println("outbox:", err)
+		}
+	}
+}
+
+// restore loads undelivered payloads from the outbox file
+func (m *Webhook) restore() error {
+	f, err := os.Open(m.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		var payload WebhookPayload
+		if err := json.Unmarshal(scanner.Bytes(), &payload); err == nil {
+			m.outbox = append(m.outbox, payload)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// persist writes undelivered payloads to the outbox file
+func (m *Webhook) persist() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.outbox) == 0 {
+		if err := os.Remove(m.file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	var b strings.Builder
+	for _, payload := range m.outbox {
+		line, err := json.Marshal(payload)
+		if err != nil {
+			continue
+		}
+		b.Write(line)
+		b.WriteString("\n")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.file), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp := m.file + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, m.file)
+}
+
+// flushOutbox attempts to deliver all undelivered payloads in order
+func (m *Webhook) flushOutbox() {
+	for {
+		m.mu.Lock()
+		if len(m.outbox) == 0 {
+			m.mu.Unlock()
+			return
+		}
+		payload := m.outbox[0]
+		m.mu.Unlock()
+
+		if err := m.post(payload); err != nil {
+			m.log.DEBUG.Printf("outbox: %v", err)
+			return
+		}
+
+		m.mu.Lock()
+		if len(m.outbox) > 0 && m.outbox[0].ID == payload.ID {
+			m.outbox = m.outbox[1:]
+		}
+		m.mu.Unlock()
+
+		if err := m.persist(); err != nil {
+			m.log.ERROR.Println("outbox:", err)
+		}
+	}
+}
+
+// run periodically retries undelivered payloads
+func (m *Webhook) run() {
+	for range time.Tick(webhookOutboxInterval) {
+		m.flushOutbox()
+	}
+}
@@ -0,0 +1,79 @@
+package push
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	var (
+		payload WebhookPayload
+		fail    = true
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(webhookSignatureHeader))
+		assert.Equal(t, "start", r.Header.Get(webhookEventHeader))
+
+		require.NoError(t, json.Unmarshal(body, &payload))
+	}))
+	defer srv.Close()
+
+	m, err := NewWebhookFromConfig(map[string]interface{}{
+		"uri":     srv.URL,
+		"secret":  "secret",
+		"events":  []string{"start"},
+		"retries": 0,
+		"outbox":  filepath.Join(t.TempDir(), "outbox"),
+	})
+	require.NoError(t, err)
+
+	wh := m.(*Webhook)
+	lp := 0
+
+	// ignored event
+	wh.SendEvent(Event{Event: "stop"}, nil)
+	assert.Empty(t, wh.outbox)
+
+	// failed delivery is stored in outbox
+	wh.SendEvent(Event{Event: "start", Loadpoint: &lp}, map[string]interface{}{"chargePower": 1000.0})
+	assert.Len(t, wh.outbox, 1)
+
+	// outbox survives restart
+	m, err = NewWebhookFromConfig(map[string]interface{}{
+		"uri":    srv.URL,
+		"secret": "secret",
+		"outbox": wh.file,
+	})
+	require.NoError(t, err)
+
+	wh = m.(*Webhook)
+	require.Len(t, wh.outbox, 1)
+
+	fail = false
+	wh.flushOutbox()
+
+	assert.Empty(t, wh.outbox)
+	assert.Equal(t, "start", payload.Event)
+	assert.Equal(t, 1, *payload.Loadpoint)
+	assert.Equal(t, 1000.0, payload.Data["chargePower"])
+}
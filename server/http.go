@@ -108,6 +108,12 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, cache *util.Cache) {
 		"sessions":      {[]string{"GET"}, "/sessions", sessionHandler},
 		"session1":      {[]string{"PUT"}, "/session/{id:[0-9]+}", updateSessionHandler},
 		"session2":      {[]string{"DELETE"}, "/session/{id:[0-9]+}", deleteSessionHandler},
+		"history":       {[]string{"GET"}, "/history", historyHandler},
+		"history2":      {[]string{"GET"}, "/history/totals", historyTotalsHandler},
+		"pushtest":      {[]string{"POST", "OPTIONS"}, "/push/test", pushTestHandler},
+		"vehiclemodel":  {[]string{"GET"}, "/vehicles/{id:[1-9][0-9]*}/model", vehicleModelHandler(site)},
+		"vehiclemodel2": {[]string{"DELETE", "OPTIONS"}, "/vehicles/{id:[1-9][0-9]*}/model", vehicleModelResetHandler(site)},
+		"vehiclestats":  {[]string{"GET"}, "/vehicles/{id:[1-9][0-9]*}/stats", vehicleStatsHandler(site)},
 		"telemetry":     {[]string{"GET"}, "/settings/telemetry", boolGetHandler(telemetry.Enabled)},
 		"telemetry2":    {[]string{"POST", "OPTIONS"}, "/settings/telemetry/{value:[a-z]+}", boolHandler(telemetry.Enable, telemetry.Enabled)},
 	}
@@ -131,9 +137,11 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, cache *util.Cache) {
 			"targettime":       {[]string{"POST", "OPTIONS"}, "/target/time/{time:[0-9TZ:.-]+}", targetTimeHandler(lp)},
 			"targettime2":      {[]string{"DELETE", "OPTIONS"}, "/target/time", targetTimeRemoveHandler(lp)},
 			"plan":             {[]string{"GET"}, "/target/plan", planHandler(lp)},
+			"trace":            {[]string{"GET"}, "/trace", traceHandler(lp)},
 			"vehicle":          {[]string{"POST", "OPTIONS"}, "/vehicle/{vehicle:[1-9][0-9]*}", vehicleHandler(site, lp)},
 			"vehicle2":         {[]string{"DELETE", "OPTIONS"}, "/vehicle", vehicleRemoveHandler(lp)},
 			"vehicleDetect":    {[]string{"PATCH", "OPTIONS"}, "/vehicle", vehicleDetectHandler(lp)},
+			"vehiclesoc":       {[]string{"POST", "OPTIONS"}, "/vehicle/soc/{value:[0-9.]+}", floatHandler(lp.SetVehicleSoc, lp.GetVehicleSoc)},
 			"remotedemand":     {[]string{"POST", "OPTIONS"}, "/remotedemand/{demand:[a-z]+}/{source::[0-9a-zA-Z_-]+}", remoteDemandHandler(lp)},
 			"enableThreshold":  {[]string{"POST", "OPTIONS"}, "/enable/threshold/{value:-?[0-9.]+}", floatHandler(pass(lp.SetEnableThreshold), lp.GetEnableThreshold)},
 			"disableThreshold": {[]string{"POST", "OPTIONS"}, "/disable/threshold/{value:-?[0-9.]+}", floatHandler(pass(lp.SetDisableThreshold), lp.GetDisableThreshold)},
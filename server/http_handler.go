@@ -399,6 +399,13 @@ func planHandler(lp loadpoint.API) http.HandlerFunc {
 	}
 }
 
+// traceHandler returns the loadpoint's recent update cycle decisions
+func traceHandler(lp loadpoint.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jsonResult(w, lp.GetTrace())
+	}
+}
+
 // socketHandler attaches websocket handler to uri
 func socketHandler(hub *SocketHub) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/evcc-io/evcc/core/history"
+)
+
+// historyRange parses the from/to query parameters defaulting to the last day
+func historyRange(q url.Values) (time.Time, time.Time, error) {
+	to := time.Now()
+	from := to.Add(-24 * time.Hour)
+
+	var err error
+	if s := q.Get("from"); s != "" {
+		if from, err = time.Parse(time.RFC3339, s); err != nil {
+			return from, to, err
+		}
+	}
+
+	if s := q.Get("to"); s != "" {
+		to, err = time.Parse(time.RFC3339, s)
+	}
+
+	return from, to, err
+}
+
+// historyHandler returns the power and energy history
+func historyHandler(w http.ResponseWriter, r *http.Request) {
+	if history.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("history not available"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	from, to, err := historyRange(q)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res := 15 * time.Minute
+	if s := q.Get("resolution"); s != "" {
+		if res, err = time.ParseDuration(s); err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	entries, err := history.Instance.Query(from, to, res)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonResult(w, entries)
+}
+
+// historyTotalsHandler returns the daily or monthly energy totals
+func historyTotalsHandler(w http.ResponseWriter, r *http.Request) {
+	if history.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("history not available"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	from, to, err := historyRange(q)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	period := history.Day
+	if s := q.Get("period"); s != "" {
+		period = history.Period(s)
+	}
+
+	stats, err := history.Instance.Totals(from, to, period)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonResult(w, stats)
+}
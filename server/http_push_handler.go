@@ -0,0 +1,42 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evcc-io/evcc/push"
+)
+
+// pushTestHandler sends a test message to all or the given recipient's messengers
+func pushTestHandler(w http.ResponseWriter, r *http.Request) {
+	if push.Instance == nil {
+		jsonError(w, http.StatusBadRequest, errors.New("push not configured"))
+		return
+	}
+
+	q := r.URL.Query()
+
+	title := q.Get("title")
+	if title == "" {
+		title = "evcc"
+	}
+
+	msg := q.Get("msg")
+	if msg == "" {
+		msg = "Test message"
+	}
+
+	count, err := push.Instance.Test(q.Get("recipient"), title, msg)
+	if err != nil {
+		jsonError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	res := struct {
+		Messengers int `json:"messengers"`
+	}{
+		Messengers: count,
+	}
+
+	jsonResult(w, res)
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/db"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/core/soc"
+	dbserver "github.com/evcc-io/evcc/server/db"
+	"github.com/gorilla/mux"
+)
+
+// siteVehicle returns the site's vehicle referenced by the 1-based id request parameter
+func siteVehicle(site site.API, r *http.Request) (api.Vehicle, error) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+
+	vehicles := site.GetVehicles()
+	if err != nil || id < 1 || id > len(vehicles) {
+		return nil, errors.New("invalid vehicle")
+	}
+
+	return vehicles[id-1], nil
+}
+
+// vehicleModelHandler returns the vehicle's learned battery model
+func vehicleModelHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if soc.Models == nil {
+			jsonError(w, http.StatusBadRequest, errors.New("database not configured"))
+			return
+		}
+
+		v, err := siteVehicle(site, r)
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		jsonResult(w, soc.Models.Get(soc.Models.Key(v)))
+	}
+}
+
+// vehicleModelResetHandler resets the vehicle's learned battery model
+func vehicleModelResetHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if soc.Models == nil {
+			jsonError(w, http.StatusBadRequest, errors.New("database not configured"))
+			return
+		}
+
+		v, err := siteVehicle(site, r)
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		if err := soc.Models.Reset(soc.Models.Key(v)); err != nil {
+			jsonError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		jsonResult(w, soc.Models.Get(soc.Models.Key(v)))
+	}
+}
+
+// vehicleStatsHandler returns the vehicle's trip and consumption statistics
+func vehicleStatsHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if dbserver.Instance == nil {
+			jsonError(w, http.StatusBadRequest, errors.New("database offline"))
+			return
+		}
+
+		v, err := siteVehicle(site, r)
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		var res db.Sessions
+		if txn := dbserver.Instance.Where(&db.Session{Vehicle: v.Title()}).Order("created").Find(&res); txn.Error != nil {
+			jsonError(w, http.StatusInternalServerError, txn.Error)
+			return
+		}
+
+		jsonResult(w, res.Stats(v.Title()))
+	}
+}
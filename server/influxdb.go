@@ -16,15 +16,25 @@ import (
 	influxlog "github.com/influxdata/influxdb-client-go/v2/log"
 )
 
+const (
+	influxSchemaKey    = "key"    // one measurement per key
+	influxSchemaDevice = "device" // one measurement per device with keys as fields
+
+	influxBatchSize = 1000 // max points per write request
+)
+
 // InfluxConfig is the influx db configuration
 type InfluxConfig struct {
 	URL      string
+	Type     string // influx (default) or http for generic line protocol endpoints
 	Database string
 	Token    string
 	Org      string
 	User     string
 	Password string
-	Interval time.Duration
+	Interval time.Duration // write interval
+	Schema   string        // measurement schema: key (default) or device
+	Buffer   string        // write buffer file for persisting unwritten points
 }
 
 // Influx is a influx publisher
@@ -33,32 +43,63 @@ type Influx struct {
 	log      *util.Logger
 	clock    clock.Clock
 	client   influxdb2.Client
-	org      string
-	database string
+	writer   recordWriter
+	batch    *batchWriter
+	schema   string
+	interval time.Duration
+	shutdown sync.Once
 }
 
 // NewInfluxClient creates new publisher for influx
-func NewInfluxClient(url, token, org, user, password, database string) *Influx {
+func NewInfluxClient(conf InfluxConfig) (*Influx, error) {
 	log := util.NewLogger("influx")
 
-	// InfluxDB v1 compatibility
-	if token == "" && user != "" {
-		token = fmt.Sprintf("%s:%s", user, password)
+	m := &Influx{
+		log:      log,
+		clock:    clock.New(),
+		schema:   strings.ToLower(conf.Schema),
+		interval: conf.Interval,
 	}
 
-	options := influxdb2.DefaultOptions().SetPrecision(time.Second)
-	client := influxdb2.NewClientWithOptions(url, token, options)
+	switch m.schema {
+	case "":
+		m.schema = influxSchemaKey
+	case influxSchemaKey, influxSchemaDevice:
+	default:
+		return nil, fmt.Errorf("invalid schema: %s", conf.Schema)
+	}
 
-	// handle error logging in writer
-	influxlog.Log = nil
+	if m.interval == 0 {
+		m.interval = 10 * time.Second
+	}
 
-	return &Influx{
-		log:      log,
-		clock:    clock.New(),
-		client:   client,
-		org:      org,
-		database: database,
+	switch strings.ToLower(conf.Type) {
+	case "", "influx":
+		token := conf.Token
+
+		// InfluxDB v1 compatibility
+		if token == "" && conf.User != "" {
+			token = fmt.Sprintf("%s:%s", conf.User, conf.Password)
+		}
+
+		options := influxdb2.DefaultOptions().SetPrecision(time.Second)
+		m.client = influxdb2.NewClientWithOptions(conf.URL, token, options)
+		m.writer = m.client.WriteAPIBlocking(conf.Org, conf.Database)
+
+		// handle error logging in writer
+		influxlog.Log = nil
+
+	case "http":
+		m.writer = newLineProtocolWriter(log, conf.URL, conf.Token, conf.User, conf.Password)
+
+	default:
+		return nil, fmt.Errorf("invalid type: %s", conf.Type)
 	}
+
+	var err error
+	m.batch, err = newBatchWriter(log, m.writer, conf.Buffer)
+
+	return m, err
 }
 
 // pointWriter is the minimal interface for influxdb2 api.Writer
@@ -69,9 +110,33 @@ type pointWriter interface {
 // writePoint asynchronously writes a point to influx
 func (m *Influx) writePoint(writer pointWriter, key string, fields map[string]any, tags map[string]string) {
 	m.log.TRACE.Printf("write %s=%v (%v)", key, fields, tags)
+
+	if m.schema == influxSchemaDevice {
+		key, fields, tags = m.deviceSchema(key, fields, tags)
+	}
+
 	writer.WritePoint(influxdb2.NewPoint(key, tags, fields, m.clock.Now()))
 }
 
+// deviceSchema maps a key's point to the device measurement using the key as field name
+func (m *Influx) deviceSchema(key string, fields map[string]any, tags map[string]string) (string, map[string]any, map[string]string) {
+	measurement := "site"
+	if _, ok := tags["loadpoint"]; ok {
+		measurement = "loadpoint"
+	}
+
+	res := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if k == "value" {
+			res[key] = v
+		} else {
+			res[key+strings.ToUpper(k)] = v
+		}
+	}
+
+	return measurement, res, tags
+}
+
 // writeComplexPoint asynchronously writes a point to influx
 func (m *Influx) writeComplexPoint(writer pointWriter, param util.Param, tags map[string]string) {
 	fields := make(map[string]any)
@@ -134,15 +199,7 @@ func (m *Influx) writeComplexPoint(writer pointWriter, param util.Param, tags ma
 
 // Run Influx publisher
 func (m *Influx) Run(site site.API, in <-chan util.Param) {
-	writer := m.client.WriteAPI(m.org, m.database)
-
-	// log errors
-	go func() {
-		for err := range writer.Errors() {
-			// log async as we're part of the logging loop
-			go m.log.ERROR.Println(err)
-		}
-	}()
+	go m.batch.Run(m.clock, m.interval)
 
 	// add points to batch for async writing
 	for param := range in {
@@ -156,8 +213,18 @@ func (m *Influx) Run(site site.API, in <-chan util.Param) {
 			}
 		}
 
-		m.writeComplexPoint(writer, param, tags)
+		m.writeComplexPoint(m.batch, param, tags)
 	}
+}
 
-	m.client.Close()
+// Shutdown writes pending points and persists the remainder to the write buffer.
+// It is registered for application shutdown and only executed once.
+func (m *Influx) Shutdown() {
+	m.shutdown.Do(func() {
+		m.batch.Stop()
+
+		if m.client != nil {
+			m.client.Close()
+		}
+	})
 }
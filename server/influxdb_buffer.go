@@ -0,0 +1,287 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"github.com/evcc-io/evcc/util/transport"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	lp "github.com/influxdata/line-protocol"
+	"github.com/mitchellh/go-homedir"
+)
+
+// influxBufferLimit is the max number of points kept while the database is unreachable
+const influxBufferLimit = 1e6
+
+// recordWriter is the minimal interface for influxdb2 api.WriteAPIBlocking
+type recordWriter interface {
+	WriteRecord(ctx context.Context, line ...string) error
+}
+
+// batchWriter collects points as line protocol records and writes them in batches.
+// Unwritten records are retained and can be persisted to survive restarts.
+type batchWriter struct {
+	mu      sync.Mutex
+	log     *util.Logger
+	writer  recordWriter
+	file    string
+	lines   []string
+	stored  int  // leading records contained in the buffer file
+	rewrite bool // buffer file contains records no longer buffered
+
+	flushMu sync.Mutex // serializes periodic and final flush
+	stopped bool
+}
+
+var _ pointWriter = (*batchWriter)(nil)
+
+// newBatchWriter creates a batch writer restoring records from the buffer file
+func newBatchWriter(log *util.Logger, writer recordWriter, file string) (*batchWriter, error) {
+	w := &batchWriter{
+		log:    log,
+		writer: writer,
+	}
+
+	if file == "" {
+		return w, nil
+	}
+
+	var err error
+	if w.file, err = homedir.Expand(file); err != nil {
+		return nil, err
+	}
+
+	return w, w.restore()
+}
+
+// restore loads unwritten records from the buffer file
+func (w *batchWriter) restore() error {
+	f, err := os.Open(w.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			w.lines = append(w.lines, line)
+		}
+	}
+
+	if len(w.lines) > 0 {
+		w.stored = len(w.lines)
+		w.log.INFO.Printf("restored %d unwritten points", len(w.lines))
+	}
+
+	return scanner.Err()
+}
+
+// persist writes unwritten records to the buffer file or removes the file if empty.
+// New records are appended, the file is only rewritten if records have been removed.
+func (w *batchWriter) persist() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == "" {
+		return nil
+	}
+
+	switch {
+	case len(w.lines) == 0:
+		if w.stored == 0 {
+			return nil
+		}
+
+		w.stored, w.rewrite = 0, false
+		return os.Remove(w.file)
+
+	case w.rewrite || w.stored == 0:
+		if err := os.MkdirAll(filepath.Dir(w.file), os.ModePerm); err != nil {
+			return err
+		}
+
+		// write atomically
+		tmp := w.file + ".tmp"
+		if err := os.WriteFile(tmp, []byte(strings.Join(w.lines, "\n")+"\n"), 0o644); err != nil {
+			return err
+		}
+
+		if err := os.Rename(tmp, w.file); err != nil {
+			return err
+		}
+
+	case w.stored < len(w.lines):
+		f, err := os.OpenFile(w.file, os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return err
+		}
+
+		_, err = f.WriteString(strings.Join(w.lines[w.stored:], "\n") + "\n")
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	w.stored, w.rewrite = len(w.lines), false
+
+	return nil
+}
+
+// WritePoint implements the pointWriter interface
+func (w *batchWriter) WritePoint(p *write.Point) {
+	var b bytes.Buffer
+	e := lp.NewEncoder(&b)
+	e.SetFieldTypeSupport(lp.UintSupport)
+	e.SetPrecision(time.Second)
+
+	if _, err := e.Encode(p); err != nil {
+		w.log.TRACE.Printf("encode %s: %v", p.Name(), err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.lines = append(w.lines, strings.TrimSuffix(b.String(), "\n"))
+
+	// drop oldest records if limit exceeded
+	if len(w.lines) > influxBufferLimit {
+		w.lines = w.lines[len(w.lines)-influxBufferLimit:]
+		w.rewrite = w.stored > 0
+	}
+}
+
+// flush writes all collected records in batches. It returns false if writing failed.
+func (w *batchWriter) flush() bool {
+	for {
+		w.mu.Lock()
+		batch, pending := w.lines, len(w.lines)
+		if len(batch) > influxBatchSize {
+			batch = batch[:influxBatchSize]
+		}
+		w.mu.Unlock()
+
+		if len(batch) == 0 {
+			return true
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), request.Timeout)
+		err := w.writer.WriteRecord(ctx, batch...)
+		cancel()
+
+		if err != nil {
+			w.log.ERROR.Printf("write: %v (%d points buffered)", err, pending)
+			return false
+		}
+
+		w.mu.Lock()
+		// records may have been dropped in the meantime
+		if n := len(batch); len(w.lines) >= n && w.lines[0] == batch[0] {
+			w.lines = w.lines[n:]
+			w.rewrite = w.stored > 0
+		}
+		w.mu.Unlock()
+	}
+}
+
+// Run periodically writes collected records until stopped
+func (w *batchWriter) Run(clock clock.Clock, interval time.Duration) {
+	ticker := clock.Ticker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		w.flushMu.Lock()
+		stopped := w.stopped
+
+		if !stopped {
+			ok := w.flush()
+
+			// keep buffer file in sync if it is in use or writing failed
+			w.mu.Lock()
+			stored := w.stored > 0
+			w.mu.Unlock()
+
+			if !ok || stored {
+				w.sync()
+			}
+		}
+
+		w.flushMu.Unlock()
+
+		if stopped {
+			return
+		}
+	}
+}
+
+// Stop ends periodic writing, writes pending records and persists the remainder
+func (w *batchWriter) Stop() {
+	w.flushMu.Lock()
+	defer w.flushMu.Unlock()
+
+	w.stopped = true
+
+	w.flush()
+	w.sync()
+}
+
+// sync persists the buffer logging errors
+func (w *batchWriter) sync() {
+	if err := w.persist(); err != nil {
+		w.log.ERROR.Println("persist:", err)
+	}
+}
+
+// lineProtocolWriter writes line protocol records to a generic http endpoint
+type lineProtocolWriter struct {
+	*request.Helper
+	uri string
+}
+
+// newLineProtocolWriter creates a line protocol writer using bearer or basic auth
+func newLineProtocolWriter(log *util.Logger, uri, token, user, password string) *lineProtocolWriter {
+	w := &lineProtocolWriter{
+		Helper: request.NewHelper(log),
+		uri:    uri,
+	}
+
+	switch {
+	case token != "":
+		w.Client.Transport = transport.BearerAuth(token, w.Client.Transport)
+	case user != "":
+		w.Client.Transport = transport.BasicAuth(user, password, w.Client.Transport)
+	}
+
+	return w
+}
+
+// WriteRecord implements the recordWriter interface
+func (w *lineProtocolWriter) WriteRecord(ctx context.Context, line ...string) error {
+	req, err := request.New(http.MethodPost, w.uri, strings.NewReader(strings.Join(line, "\n")), map[string]string{
+		"Content-Type": "text/plain; charset=utf-8",
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.DoBody(req.WithContext(ctx))
+	return err
+}
@@ -1,13 +1,19 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/util"
 	inf2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type influxWriter struct {
@@ -88,3 +94,119 @@ func TestInfluxTypes(t *testing.T) {
 		w.finish()
 	}
 }
+
+func TestInfluxDeviceSchema(t *testing.T) {
+	m := &Influx{
+		log:    util.NewLogger("foo"),
+		clock:  clock.NewMock(),
+		schema: influxSchemaDevice,
+	}
+
+	{
+		// site value
+		w := &influxWriter{
+			t: t, p: []*write.Point{inf2.NewPoint("site", nil, map[string]any{"gridPower": 1.0}, m.clock.Now())},
+		}
+		m.writeComplexPoint(w, util.Param{Key: "gridPower", Val: 1.0}, nil)
+		w.finish()
+	}
+
+	{
+		// loadpoint phases
+		tags := map[string]string{"loadpoint": "lp"}
+		w := &influxWriter{
+			t: t, p: []*write.Point{inf2.NewPoint("loadpoint", tags, map[string]any{
+				"chargeCurrentsL1": 1.0,
+				"chargeCurrentsL2": 2.0,
+				"chargeCurrentsL3": 3.0,
+			}, m.clock.Now())},
+		}
+		m.writeComplexPoint(w, util.Param{Key: "chargeCurrents", Val: []float64{1, 2, 3}}, tags)
+		w.finish()
+	}
+}
+
+type influxRecordWriter struct {
+	lines []string
+	err   error
+}
+
+func (w *influxRecordWriter) WriteRecord(_ context.Context, line ...string) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.lines = append(w.lines, line...)
+	return nil
+}
+
+func TestInfluxBatchBuffer(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "influx.buffer")
+	rw := &influxRecordWriter{err: errors.New("offline")}
+
+	w, err := newBatchWriter(util.NewLogger("foo"), rw, file)
+	require.NoError(t, err)
+
+	ts := time.Unix(1000, 0)
+	w.WritePoint(inf2.NewPoint("foo", nil, map[string]any{"value": 1}, ts))
+	w.WritePoint(inf2.NewPoint("foo", nil, map[string]any{"value": 2}, ts.Add(time.Second)))
+
+	// database offline
+	assert.False(t, w.flush())
+	require.NoError(t, w.persist())
+
+	// new points are appended
+	w.WritePoint(inf2.NewPoint("foo", nil, map[string]any{"value": 3}, ts.Add(2*time.Second)))
+	assert.False(t, w.flush())
+	require.NoError(t, w.persist())
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "foo value=1i 1000\nfoo value=2i 1001\nfoo value=3i 1002\n", string(b))
+
+	// restart restores original timestamps
+	rw.err = nil
+	w, err = newBatchWriter(util.NewLogger("foo"), rw, file)
+	require.NoError(t, err)
+
+	assert.True(t, w.flush())
+	assert.Equal(t, []string{"foo value=1i 1000", "foo value=2i 1001", "foo value=3i 1002"}, rw.lines)
+
+	// buffer removed after backfill
+	require.NoError(t, w.persist())
+	_, err = os.Stat(file)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestInfluxBatchStop(t *testing.T) {
+	clck := clock.NewMock()
+	rw := new(influxRecordWriter)
+
+	w, err := newBatchWriter(util.NewLogger("foo"), rw, "")
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		w.Run(clck, time.Second)
+		close(done)
+	}()
+
+	// final flush writes pending points
+	w.WritePoint(inf2.NewPoint("foo", nil, map[string]any{"value": 1}, time.Unix(1000, 0)))
+	w.Stop()
+	assert.Equal(t, []string{"foo value=1i 1000"}, rw.lines)
+
+	// periodic writing ends
+	w.WritePoint(inf2.NewPoint("foo", nil, map[string]any{"value": 2}, time.Unix(1001, 0)))
+	require.Eventually(t, func() bool {
+		clck.Add(time.Second)
+
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond, "not stopped")
+
+	assert.Len(t, rw.lines, 1)
+}
@@ -133,6 +133,11 @@ func (m *MQTT) listenSetters(topic string, site site.API, lp loadpoint.API) {
 			lp.SetTargetSoc(soc)
 		}
 	})
+	m.Handler.ListenSetter(topic+"/vehicleSoc/set", func(payload string) {
+		if soc, err := strconv.ParseFloat(payload, 64); err == nil {
+			_ = lp.SetVehicleSoc(soc)
+		}
+	})
 	m.Handler.ListenSetter(topic+"/targetTime/set", func(payload string) {
 		if val, err := time.Parse(time.RFC3339, payload); err == nil {
 			_ = lp.SetTargetTime(val)
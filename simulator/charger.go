@@ -0,0 +1,233 @@
+package simulator
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// Charger is a simulated charger following its setpoints after a ramp delay
+type Charger struct {
+	m       *Model
+	vehicle string        // simulated vehicle id
+	delay   time.Duration // ramp delay until setpoints become effective
+	wired   int           // physical phases limiting the phase setpoint
+
+	// setpoints
+	enabled bool
+	current float64
+	phases  int
+	changed time.Time
+
+	// effective state
+	effEnabled bool
+	effCurrent float64
+	effPhases  int
+
+	power    float64 // W
+	currents [3]float64
+	energy   float64 // kWh
+}
+
+// AddCharger adds a charger with the given vehicle plugged in to the model
+func (m *Model) AddCharger(vehicle string, phases int, delay time.Duration) *Charger {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if phases == 0 {
+		phases = 3
+	}
+
+	c := &Charger{
+		m:         m,
+		vehicle:   vehicle,
+		delay:     delay,
+		wired:     phases,
+		current:   6,
+		phases:    phases,
+		effPhases: phases,
+		changed:   m.clock.Now(),
+	}
+
+	m.chargers = append(m.chargers, c)
+
+	return c
+}
+
+// connected returns the plugged-in vehicle or nil
+func (c *Charger) connected() *Vehicle {
+	if v, ok := c.m.vehicles[c.vehicle]; ok && v.connected {
+		return v
+	}
+	return nil
+}
+
+// step applies setpoints after the ramp delay and charges the vehicle, returning charge power
+func (c *Charger) step(ts time.Time, hours float64) float64 {
+	if ts.Sub(c.changed) >= c.delay {
+		c.effEnabled = c.enabled
+		c.effCurrent = c.current
+		c.effPhases = c.phases
+	}
+
+	c.power = 0
+	c.currents = [3]float64{}
+
+	v := c.connected()
+	if v == nil || !c.effEnabled {
+		return 0
+	}
+
+	phases := c.effPhases
+	if v.cfg.Phases < phases {
+		phases = v.cfg.Phases
+	}
+
+	current := math.Min(c.effCurrent, v.cfg.MaxCurrent)
+	c.power = v.acceptance(current*float64(phases)*Voltage, phases)
+	current = c.power / float64(phases) / Voltage
+
+	for i := 0; i < phases; i++ {
+		c.currents[i] = current
+	}
+
+	kWh := c.power * hours / 1e3
+	c.energy += kWh
+	v.charge(kWh)
+
+	return c.power
+}
+
+// setpoint updates the setpoints to become effective after the ramp delay
+func (c *Charger) setpoint(fun func()) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	c.m.update()
+	fun()
+	c.changed = c.m.clock.Now()
+}
+
+var _ api.Charger = (*Charger)(nil)
+
+// Status implements the api.Charger interface
+func (c *Charger) Status() (api.ChargeStatus, error) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	c.m.update()
+
+	switch {
+	case c.connected() == nil:
+		return api.StatusA, nil
+	case c.power > 0:
+		return api.StatusC, nil
+	default:
+		return api.StatusB, nil
+	}
+}
+
+// Enabled implements the api.Charger interface
+func (c *Charger) Enabled() (bool, error) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	return c.enabled, nil
+}
+
+// Enable implements the api.Charger interface
+func (c *Charger) Enable(enable bool) error {
+	c.setpoint(func() { c.enabled = enable })
+	return nil
+}
+
+// MaxCurrent implements the api.Charger interface
+func (c *Charger) MaxCurrent(current int64) error {
+	return c.MaxCurrentMillis(float64(current))
+}
+
+var _ api.ChargerEx = (*Charger)(nil)
+
+// MaxCurrentMillis implements the api.ChargerEx interface
+func (c *Charger) MaxCurrentMillis(current float64) error {
+	if current < 6 {
+		return errors.New("invalid current")
+	}
+
+	c.setpoint(func() { c.current = current })
+	return nil
+}
+
+var _ api.PhaseSwitcher = (*Charger)(nil)
+
+// Phases1p3p implements the api.PhaseSwitcher interface
+func (c *Charger) Phases1p3p(phases int) error {
+	// phases are limited by the charger's wiring
+	if phases > c.wired {
+		phases = c.wired
+	}
+
+	c.setpoint(func() {
+		c.phases = phases
+		// switching interrupts charging
+		c.effEnabled = false
+	})
+	return nil
+}
+
+var _ api.Meter = (*Charger)(nil)
+
+// CurrentPower implements the api.Meter interface
+func (c *Charger) CurrentPower() (float64, error) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	c.m.update()
+	return c.power, nil
+}
+
+var _ api.MeterEnergy = (*Charger)(nil)
+
+// TotalEnergy implements the api.MeterEnergy interface
+func (c *Charger) TotalEnergy() (float64, error) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	c.m.update()
+	return c.energy, nil
+}
+
+var _ api.PhaseCurrents = (*Charger)(nil)
+
+// Currents implements the api.PhaseCurrents interface
+func (c *Charger) Currents() (float64, float64, float64, error) {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+
+	c.m.update()
+	return c.currents[0], c.currents[1], c.currents[2], nil
+}
+
+var _ api.ChargeState = (*Vehicle)(nil)
+
+// Status implements the api.ChargeState interface
+func (v *Vehicle) Status() (api.ChargeStatus, error) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	v.m.update()
+
+	if !v.connected {
+		return api.StatusA, nil
+	}
+
+	for _, c := range v.m.chargers {
+		if c.connected() == v && c.power > 0 {
+			return api.StatusC, nil
+		}
+	}
+
+	return api.StatusB, nil
+}
@@ -0,0 +1,284 @@
+package simulator
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+)
+
+const (
+	// Voltage is the simulated grid voltage
+	Voltage = 230
+
+	maxStep = 10 * time.Second // integration step
+)
+
+var (
+	mu     sync.Mutex
+	models = make(map[string]*Model)
+)
+
+// Instance returns the named simulation model, creating it if required.
+// All simulator devices referencing the same model share its physical state.
+func Instance(name string) *Model {
+	mu.Lock()
+	defer mu.Unlock()
+
+	name = strings.ToLower(name)
+	if name == "" {
+		name = "default"
+	}
+
+	m, ok := models[name]
+	if !ok {
+		m = New(clock.New())
+		models[name] = m
+	}
+
+	return m
+}
+
+// Model is the simulated physical site, balancing pv generation, home load,
+// battery and chargers against the grid
+type Model struct {
+	mu      sync.Mutex
+	clock   clock.Clock
+	rand    *rand.Rand
+	updated time.Time
+
+	pv       *Generator
+	home     *Load
+	battery  *Battery
+	chargers []*Charger
+	vehicles map[string]*Vehicle
+
+	pvLimit float64 // inverter active power limit, negative if not curtailed
+
+	pvPower, homePower, batteryPower, gridPower         float64
+	pvEnergy, gridEnergy, gridExportEnergy, pvCurtailed float64 // kWh
+}
+
+// New creates a simulation model using the given clock
+func New(clock clock.Clock) *Model {
+	return &Model{
+		clock:    clock,
+		rand:     rand.New(rand.NewSource(clock.Now().UnixNano())),
+		updated:  clock.Now(),
+		home:     &Load{Profile: HomeProfile},
+		vehicles: make(map[string]*Vehicle),
+		pvLimit:  -1,
+	}
+}
+
+// Seed makes the simulated noise reproducible
+func (m *Model) Seed(seed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rand = rand.New(rand.NewSource(seed))
+}
+
+// noise returns the value with relative gaussian noise applied
+func (m *Model) noise(val, noise float64) float64 {
+	if noise == 0 {
+		return val
+	}
+	return math.Max(0, val*(1+noise*m.rand.NormFloat64()))
+}
+
+// update advances the model to the current time. Must be called with lock held.
+func (m *Model) update() {
+	now := m.clock.Now()
+
+	for m.updated.Before(now) {
+		dt := now.Sub(m.updated)
+		if dt > maxStep {
+			dt = maxStep
+		}
+
+		m.updated = m.updated.Add(dt)
+		m.step(m.updated, dt.Hours())
+	}
+}
+
+// step balances the site at the given time and integrates energies over the step duration
+func (m *Model) step(ts time.Time, hours float64) {
+	var chargePower float64
+	for _, c := range m.chargers {
+		chargePower += c.step(ts, hours)
+	}
+
+	m.pvPower = 0
+	if m.pv != nil {
+		m.pvPower = m.noise(m.pv.Peak*m.pv.Profile.At(ts), m.pv.Noise)
+	}
+
+	if m.pvLimit >= 0 && m.pvPower > m.pvLimit {
+		m.pvCurtailed += (m.pvPower - m.pvLimit) * hours / 1e3
+		m.pvPower = m.pvLimit
+	}
+
+	m.homePower = m.noise(m.home.Profile.At(ts), m.home.Noise)
+
+	// positive demand is covered by battery discharge or grid import
+	demand := m.homePower + chargePower - m.pvPower
+
+	m.batteryPower = 0
+	if m.battery != nil {
+		m.batteryPower = m.battery.step(demand, hours)
+	}
+
+	m.gridPower = demand - m.batteryPower
+
+	m.pvEnergy += m.pvPower * hours / 1e3
+	m.gridEnergy += math.Max(0, m.gridPower) * hours / 1e3
+	m.gridExportEnergy += math.Max(0, -m.gridPower) * hours / 1e3
+}
+
+// SetGenerator configures the model's pv generation
+func (m *Model) SetGenerator(g *Generator) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if empty(g.Profile) {
+		g.Profile = SolarProfile(6, 20)
+	}
+	m.pv = g
+}
+
+// SetLoad configures the model's home load
+func (m *Model) SetLoad(l *Load) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if empty(l.Profile) {
+		l.Profile = HomeProfile
+	}
+	m.home = l
+}
+
+// empty checks if the curve is undefined
+func empty(c Curve) bool {
+	p, ok := c.(Profile)
+	return c == nil || ok && len(p) == 0
+}
+
+// SetBattery configures the model's home battery
+func (m *Model) SetBattery(b *Battery) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if b.MaxPower == 0 {
+		b.MaxPower = 5000
+	}
+	m.battery = b
+}
+
+// GridPower returns the grid power, positive values are import
+func (m *Model) GridPower() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.gridPower, nil
+}
+
+// GridEnergy returns the imported grid energy in kWh
+func (m *Model) GridEnergy() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.gridEnergy, nil
+}
+
+// GridExportEnergy returns the exported grid energy in kWh
+func (m *Model) GridExportEnergy() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.gridExportEnergy, nil
+}
+
+// PVPower returns the pv generation power
+func (m *Model) PVPower() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.pvPower, nil
+}
+
+// PVEnergy returns the generated pv energy in kWh
+func (m *Model) PVEnergy() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.pvEnergy, nil
+}
+
+// PVCurtailedEnergy returns the pv energy lost to curtailment in kWh
+func (m *Model) PVCurtailedEnergy() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.pvCurtailed, nil
+}
+
+// Curtail limits the pv generation power, negative limits release the curtailment
+func (m *Model) Curtail(limit float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	m.pvLimit = limit
+	return nil
+}
+
+// HomePower returns the home load power
+func (m *Model) HomePower() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.homePower, nil
+}
+
+// BatteryPower returns the battery power, positive values are discharging
+func (m *Model) BatteryPower() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	return m.batteryPower, nil
+}
+
+// BatterySoc returns the battery soc
+func (m *Model) BatterySoc() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.update()
+	if m.battery == nil {
+		return 0, nil
+	}
+	return m.battery.Soc, nil
+}
+
+// BatteryCapacity returns the battery capacity in kWh
+func (m *Model) BatteryCapacity() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.battery == nil {
+		return 0
+	}
+	return m.battery.Capacity
+}
@@ -0,0 +1,151 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	p := Profile{0, 100}
+	noon := time.Date(2023, 6, 1, 12, 0, 0, 0, time.Local)
+
+	assert.Equal(t, 0.0, p.At(noon.Add(-12*time.Hour)))
+	assert.Equal(t, 100.0, p.At(noon))
+	assert.Equal(t, 50.0, p.At(noon.Add(6*time.Hour)))
+
+	assert.InDelta(t, 1.0, SolarProfile(6, 18).At(noon), 1e-9)
+	assert.Equal(t, 0.0, SolarProfile(6, 18).At(noon.Add(-7*time.Hour)))
+}
+
+func TestChargerFollowsSetpoints(t *testing.T) {
+	clock := clock.NewMock()
+	m := New(clock)
+	m.SetLoad(&Load{Profile: Profile{0}})
+
+	v := m.AddVehicle("ev", VehicleConfig{Capacity: 10, Soc: 50})
+	c := m.AddCharger("ev", 3, 5*time.Second)
+
+	status, err := c.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+
+	require.NoError(t, c.MaxCurrent(16))
+	require.NoError(t, c.Enable(true))
+
+	// ramp delay
+	clock.Add(time.Second)
+	power, _ := c.CurrentPower()
+	assert.Equal(t, 0.0, power)
+
+	clock.Add(5 * time.Second)
+	power, _ = c.CurrentPower()
+	assert.Equal(t, float64(16*3*Voltage), power)
+
+	status, _ = v.Status()
+	assert.Equal(t, api.StatusC, status)
+
+	grid, _ := m.GridPower()
+	assert.Equal(t, power, grid)
+
+	// soc follows charged energy
+	clock.Add(10 * time.Minute)
+	soc, _ := v.Soc()
+	assert.InDelta(t, 50+11.04*10/60*0.9/10*100, soc, 0.5)
+
+	// phase switching interrupts charging and reduces power
+	require.NoError(t, c.Phases1p3p(1))
+	clock.Add(time.Second)
+	power, _ = c.CurrentPower()
+	assert.Equal(t, 0.0, power)
+
+	clock.Add(5 * time.Second)
+	power, _ = c.CurrentPower()
+	assert.Equal(t, float64(16*Voltage), power)
+
+	// phases are limited by the charger's wiring
+	c1 := m.AddCharger("ev", 1, 0)
+	require.NoError(t, c1.Phases1p3p(3))
+	assert.Equal(t, 1, c1.phases)
+
+	// disconnected
+	v.SetConnected(false)
+	status, _ = c.Status()
+	assert.Equal(t, api.StatusA, status)
+}
+
+func TestChargeCurve(t *testing.T) {
+	v := &Vehicle{cfg: VehicleConfig{Phases: 3, MaxCurrent: 16, TaperSoc: 80}}
+
+	v.soc = 50
+	assert.Equal(t, 11040.0, v.acceptance(11040, 3))
+
+	v.soc = 90
+	assert.InDelta(t, 11040*0.6, v.acceptance(11040, 3), 1e-6)
+
+	// taper limit follows the phases in use
+	assert.InDelta(t, 3680*0.6, v.acceptance(3680, 1), 1e-6)
+
+	v.soc = 100
+	assert.Equal(t, 0.0, v.acceptance(11040, 3))
+}
+
+func TestBatteryBalancesGrid(t *testing.T) {
+	clock := clock.NewMock()
+	clock.Set(time.Date(2023, 6, 1, 12, 0, 0, 0, time.Local))
+
+	m := New(clock)
+	m.SetLoad(&Load{Profile: Profile{500}})
+	m.SetGenerator(&Generator{Peak: 3000, Profile: Profile{1}})
+	m.SetBattery(&Battery{Capacity: 10, Soc: 50, MaxPower: 2000})
+
+	clock.Add(time.Hour)
+
+	battery, _ := m.BatteryPower()
+	assert.Equal(t, -2000.0, battery)
+
+	grid, _ := m.GridPower()
+	assert.Equal(t, -500.0, grid)
+
+	soc, _ := m.BatterySoc()
+	assert.InDelta(t, 70, soc, 1e-6)
+}
+
+func TestCurtailment(t *testing.T) {
+	clock := clock.NewMock()
+	clock.Set(time.Date(2023, 6, 1, 12, 0, 0, 0, time.Local))
+
+	m := New(clock)
+	m.SetLoad(&Load{Profile: Profile{500}})
+	m.SetGenerator(&Generator{Peak: 3000, Profile: Profile{1}})
+
+	assert.NoError(t, m.Curtail(500))
+	clock.Add(time.Hour)
+
+	grid, _ := m.GridPower()
+	assert.Equal(t, 0.0, grid)
+
+	curtailed, _ := m.PVCurtailedEnergy()
+	assert.InDelta(t, 2.5, curtailed, 1e-6)
+
+	assert.NoError(t, m.Curtail(-1))
+	clock.Add(time.Minute)
+
+	pv, _ := m.PVPower()
+	assert.Equal(t, 3000.0, pv)
+}
+
+func TestSeries(t *testing.T) {
+	ts := time.Date(2023, 6, 1, 12, 0, 0, 0, time.Local)
+	s := Series{{ts, 100}, {ts.Add(time.Hour), 200}}
+
+	assert.Equal(t, 100.0, s.At(ts.Add(-time.Hour)))
+	assert.Equal(t, 100.0, s.At(ts))
+	assert.Equal(t, 100.0, s.At(ts.Add(30*time.Minute)))
+	assert.Equal(t, 200.0, s.At(ts.Add(2*time.Hour)))
+	assert.Equal(t, 0.0, Series(nil).At(ts))
+}
@@ -0,0 +1,44 @@
+package simulator
+
+import (
+	"math"
+	"time"
+)
+
+// Profile is a daily profile of hourly values starting at midnight, linearly interpolated in between
+type Profile []float64
+
+// At returns the profile value at the given time of day
+func (p Profile) At(t time.Time) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+
+	h := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+	h *= float64(len(p)) / 24
+
+	i := int(h) % len(p)
+	j := (i + 1) % len(p)
+	frac := h - math.Floor(h)
+
+	return p[i] + (p[j]-p[i])*frac
+}
+
+// SolarProfile is a clear sky pv profile between sunrise and sunset, normalized to 1 at noon
+func SolarProfile(sunrise, sunset float64) Profile {
+	res := make(Profile, 24)
+	for h := range res {
+		if x := (float64(h) - sunrise) / (sunset - sunrise); x > 0 && x < 1 {
+			res[h] = math.Sin(math.Pi * x)
+		}
+	}
+	return res
+}
+
+// HomeProfile is a typical household load profile in W with morning and evening peaks
+var HomeProfile = Profile{
+	250, 220, 200, 200, 200, 220, // 0-5
+	400, 700, 600, 450, 400, 500, // 6-11
+	650, 500, 400, 400, 450, 600, // 12-17
+	900, 1000, 800, 600, 400, 300, // 18-23
+}
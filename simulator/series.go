@@ -0,0 +1,42 @@
+package simulator
+
+import (
+	"sort"
+	"time"
+)
+
+// Curve is a time-dependent value
+type Curve interface {
+	At(time.Time) float64
+}
+
+var (
+	_ Curve = Profile(nil)
+	_ Curve = Series(nil)
+)
+
+// Sample is a recorded value
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is a recorded time series sorted by time. Each value is held until the next sample,
+// preserving the energy of recorded averages.
+type Series []Sample
+
+// At returns the series value at the given time
+func (s Series) At(t time.Time) float64 {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].Time.After(t)
+	})
+
+	if i == 0 {
+		if len(s) == 0 {
+			return 0
+		}
+		return s[0].Value
+	}
+
+	return s[i-1].Value
+}
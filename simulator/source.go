@@ -0,0 +1,38 @@
+package simulator
+
+import "math"
+
+// Generator is a pv generator following a daily profile
+type Generator struct {
+	Peak    float64 // peak power in W
+	Profile Curve   // factors of peak power, defaults to clear sky from 6 to 20h
+	Noise   float64 // relative noise, e.g. 0.1 for clouds
+}
+
+// Load is the household load following a daily profile
+type Load struct {
+	Profile Curve   // power in W, defaults to typical household
+	Noise   float64 // relative noise
+}
+
+// Battery is a home battery compensating the site's grid balance
+type Battery struct {
+	Capacity float64 // kWh
+	Soc      float64 // %
+	MinSoc   float64 // % discharge limit
+	MaxPower float64 // W charge and discharge limit
+}
+
+// step returns the battery power covering the demand, positive values are discharging,
+// and integrates the battery soc
+func (b *Battery) step(demand, hours float64) float64 {
+	power := math.Max(-b.MaxPower, math.Min(demand, b.MaxPower))
+
+	if power > 0 && b.Soc <= b.MinSoc || power < 0 && b.Soc >= 100 || b.Capacity <= 0 {
+		return 0
+	}
+
+	b.Soc = math.Max(0, math.Min(100, b.Soc-power*hours/1e3/b.Capacity*100))
+
+	return power
+}
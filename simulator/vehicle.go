@@ -0,0 +1,143 @@
+package simulator
+
+import (
+	"math"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// VehicleConfig is the simulated vehicle configuration
+type VehicleConfig struct {
+	Capacity     float64 // kWh
+	Soc          float64 // initial soc in %
+	Phases       int     // phases the vehicle charges with
+	MaxCurrent   float64 // A
+	TaperSoc     float64 // soc above which charge power is reduced
+	Efficiency   float64 // charge efficiency
+	Consumption  float64 // kWh/100km
+	Disconnected bool    // initially disconnected
+}
+
+// Vehicle is a simulated vehicle whose soc follows the energy charged
+type Vehicle struct {
+	m   *Model
+	cfg VehicleConfig
+
+	soc       float64
+	connected bool
+}
+
+// AddVehicle adds a vehicle to the model, applying defaults
+func (m *Model) AddVehicle(id string, cc VehicleConfig) *Vehicle {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if cc.Capacity == 0 {
+		cc.Capacity = 50
+	}
+	if cc.Phases == 0 {
+		cc.Phases = 3
+	}
+	if cc.MaxCurrent == 0 {
+		cc.MaxCurrent = 16
+	}
+	if cc.TaperSoc == 0 {
+		cc.TaperSoc = 80
+	}
+	if cc.Efficiency == 0 {
+		cc.Efficiency = 0.9
+	}
+	if cc.Consumption == 0 {
+		cc.Consumption = 18
+	}
+
+	v := &Vehicle{
+		m:         m,
+		cfg:       cc,
+		soc:       cc.Soc,
+		connected: !cc.Disconnected,
+	}
+
+	m.vehicles[id] = v
+
+	return v
+}
+
+// acceptance returns the power the vehicle accepts on the given phases according to its charge curve
+func (v *Vehicle) acceptance(power float64, phases int) float64 {
+	if v.soc >= 100 {
+		return 0
+	}
+
+	if v.soc > v.cfg.TaperSoc {
+		// reduce linearly to 20% at full soc
+		limit := float64(phases) * Voltage * v.cfg.MaxCurrent * (1 - 0.8*(v.soc-v.cfg.TaperSoc)/(100-v.cfg.TaperSoc))
+		power = math.Min(power, limit)
+	}
+
+	return power
+}
+
+// charge adds the charged energy to the soc
+func (v *Vehicle) charge(kWh float64) {
+	v.soc = math.Min(100, v.soc+kWh*v.cfg.Efficiency/v.cfg.Capacity*100)
+}
+
+// Config returns the vehicle configuration including defaults
+func (v *Vehicle) Config() VehicleConfig {
+	return v.cfg
+}
+
+// Connected returns the vehicle's connection state
+func (v *Vehicle) Connected() bool {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	return v.connected
+}
+
+// SetConnected connects or disconnects the vehicle
+func (v *Vehicle) SetConnected(connected bool) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	v.m.update()
+	v.connected = connected
+}
+
+// SetSoc sets the vehicle soc, e.g. when arriving after a trip
+func (v *Vehicle) SetSoc(soc float64) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	v.m.update()
+	v.soc = soc
+}
+
+// Drive discharges the vehicle by the given distance in km
+func (v *Vehicle) Drive(distance float64) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	v.m.update()
+	v.soc = math.Max(0, v.soc-distance*v.cfg.Consumption/100/v.cfg.Capacity*100)
+}
+
+var _ api.Battery = (*Vehicle)(nil)
+
+// Soc implements the api.Battery interface
+func (v *Vehicle) Soc() (float64, error) {
+	v.m.mu.Lock()
+	defer v.m.mu.Unlock()
+
+	v.m.update()
+	return v.soc, nil
+}
+
+var _ api.VehicleRange = (*Vehicle)(nil)
+
+// Range implements the api.VehicleRange interface
+func (v *Vehicle) Range() (int64, error) {
+	soc, err := v.Soc()
+	return int64(soc / 100 * v.cfg.Capacity / v.cfg.Consumption * 100), err
+}
@@ -86,6 +86,11 @@ func NewFixedFromConfig(other map[string]interface{}) (api.Tariff, error) {
 	return t, nil
 }
 
+// SetClock sets the tariff's clock
+func (t *Fixed) SetClock(clock clock.Clock) {
+	t.clock = clock
+}
+
 // Unit implements the api.Tariff interface
 func (t *Fixed) Unit() string {
 	return t.unit
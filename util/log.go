@@ -72,6 +72,18 @@ func (l *Logger) Redact(items ...string) *Logger {
 	return l
 }
 
+// RedactAll returns p with the redaction items of all loggers replaced
+func RedactAll(p []byte) []byte {
+	loggersMux.Lock()
+	defer loggersMux.Unlock()
+
+	for _, logger := range loggers {
+		p = logger.Redacted(p)
+	}
+
+	return p
+}
+
 // Loggers invokes callback for each configured logger
 func Loggers(cb func(string, *Logger)) {
 	for name, logger := range loggers {
@@ -1,6 +1,7 @@
 package modbus
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/trace"
 	"github.com/grid-x/modbus"
 	"github.com/volkszaehler/mbmd/encoding"
 	"github.com/volkszaehler/mbmd/meters"
@@ -58,6 +60,7 @@ type Connection struct {
 	slaveID uint8
 	mu      sync.Mutex
 	conn    meters.Connection
+	sched   *scheduler
 	delay   time.Duration
 }
 
@@ -75,6 +78,19 @@ func (mb *Connection) handle(res []byte, err error) ([]byte, error) {
 	return res, err
 }
 
+// uint16Bytes encodes the value as big endian register data
+func uint16Bytes(value uint16) []byte {
+	return binary.BigEndian.AppendUint16(nil, value)
+}
+
+// exec executes the modbus operation or replays it from trace
+func (mb *Connection) exec(slaveID, funcCode uint8, address, quantity uint16, value []byte, fun func() ([]byte, error)) ([]byte, error) {
+	return trace.ModbusOp(slaveID, funcCode, address, quantity, value, func() ([]byte, error) {
+		mb.prepare(slaveID)
+		return mb.handle(fun())
+	})
+}
+
 // Delay sets delay so use between subsequent modbus operations
 func (mb *Connection) Delay(delay time.Duration) {
 	mb.delay = delay
@@ -99,88 +115,109 @@ func (mb *Connection) Timeout(timeout time.Duration) {
 func (mb *Connection) ReadCoilsWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadCoils(address, quantity))
+	return mb.exec(slaveID, modbus.FuncCodeReadCoils, address, quantity, nil, func() ([]byte, error) {
+		return mb.conn.ModbusClient().ReadCoils(address, quantity)
+	})
 }
 
 // WriteSingleCoil wraps the underlying implementation
 func (mb *Connection) WriteSingleCoilWithSlave(slaveID uint8, address, value uint16) ([]byte, error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteSingleCoil(address, value))
+	return mb.exec(slaveID, modbus.FuncCodeWriteSingleCoil, address, 1, uint16Bytes(value), func() ([]byte, error) {
+		return mb.conn.ModbusClient().WriteSingleCoil(address, value)
+	})
 }
 
 // ReadInputRegisters wraps the underlying implementation
 func (mb *Connection) ReadInputRegistersWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadInputRegisters(address, quantity))
+	return mb.sched.read(slaveID, modbus.FuncCodeReadInputRegisters, address, quantity, func(address, quantity uint16) ([]byte, error) {
+		mb.mu.Lock()
+		defer mb.mu.Unlock()
+		return mb.exec(slaveID, modbus.FuncCodeReadInputRegisters, address, quantity, nil, func() ([]byte, error) {
+			return mb.conn.ModbusClient().ReadInputRegisters(address, quantity)
+		})
+	})
 }
 
 // ReadHoldingRegisters wraps the underlying implementation
 func (mb *Connection) ReadHoldingRegistersWithSlave(slaveID uint8, address, quantity uint16) ([]byte, error) {
-	mb.mu.Lock()
-	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadHoldingRegisters(address, quantity))
+	return mb.sched.read(slaveID, modbus.FuncCodeReadHoldingRegisters, address, quantity, func(address, quantity uint16) ([]byte, error) {
+		mb.mu.Lock()
+		defer mb.mu.Unlock()
+		return mb.exec(slaveID, modbus.FuncCodeReadHoldingRegisters, address, quantity, nil, func() ([]byte, error) {
+			return mb.conn.ModbusClient().ReadHoldingRegisters(address, quantity)
+		})
+	})
 }
 
 // WriteSingleRegister wraps the underlying implementation
 func (mb *Connection) WriteSingleRegisterWithSlave(slaveID uint8, address, value uint16) ([]byte, error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteSingleRegister(address, value))
+	return mb.exec(slaveID, modbus.FuncCodeWriteSingleRegister, address, 1, uint16Bytes(value), func() ([]byte, error) {
+		return mb.conn.ModbusClient().WriteSingleRegister(address, value)
+	})
 }
 
 // WriteMultipleRegisters wraps the underlying implementation
 func (mb *Connection) WriteMultipleRegistersWithSlave(slaveID uint8, address, quantity uint16, value []byte) ([]byte, error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteMultipleRegisters(address, quantity, value))
+	return mb.exec(slaveID, modbus.FuncCodeWriteMultipleRegisters, address, quantity, value, func() ([]byte, error) {
+		return mb.conn.ModbusClient().WriteMultipleRegisters(address, quantity, value)
+	})
 }
 
 // ReadDiscreteInputs wraps the underlying implementation
 func (mb *Connection) ReadDiscreteInputsWithSlave(slaveID uint8, address, quantity uint16) (results []byte, err error) {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadDiscreteInputs(address, quantity))
+	return mb.exec(slaveID, modbus.FuncCodeReadDiscreteInputs, address, quantity, nil, func() ([]byte, error) {
+		return mb.conn.ModbusClient().ReadDiscreteInputs(address, quantity)
+	})
 }
 
 // WriteMultipleCoils wraps the underlying implementation
 func (mb *Connection) WriteMultipleCoilsWithSlave(slaveID uint8, address, quantity uint16, value []byte) (results []byte, err error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().WriteMultipleCoils(address, quantity, value))
+	return mb.exec(slaveID, modbus.FuncCodeWriteMultipleCoils, address, quantity, value, func() ([]byte, error) {
+		return mb.conn.ModbusClient().WriteMultipleCoils(address, quantity, value)
+	})
 }
 
 // ReadWriteMultipleRegisters wraps the underlying implementation
 func (mb *Connection) ReadWriteMultipleRegistersWithSlave(slaveID uint8, readAddress, readQuantity, writeAddress, writeQuantity uint16, value []byte) (results []byte, err error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value))
+	return mb.exec(slaveID, modbus.FuncCodeReadWriteMultipleRegisters, readAddress, readQuantity, append(uint16Bytes(writeAddress), value...), func() ([]byte, error) {
+		return mb.conn.ModbusClient().ReadWriteMultipleRegisters(readAddress, readQuantity, writeAddress, writeQuantity, value)
+	})
 }
 
 // MaskWriteRegister wraps the underlying implementation
 func (mb *Connection) MaskWriteRegisterWithSlave(slaveID uint8, address, andMask, orMask uint16) (results []byte, err error) {
+	defer mb.sched.invalidate(slaveID)
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().MaskWriteRegister(address, andMask, orMask))
+	return mb.exec(slaveID, modbus.FuncCodeMaskWriteRegister, address, 1, append(uint16Bytes(andMask), uint16Bytes(orMask)...), func() ([]byte, error) {
+		return mb.conn.ModbusClient().MaskWriteRegister(address, andMask, orMask)
+	})
 }
 
 // ReadFIFOQueue wraps the underlying implementation
 func (mb *Connection) ReadFIFOQueueWithSlave(slaveID uint8, address uint16) (results []byte, err error) {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	mb.prepare(slaveID)
-	return mb.handle(mb.conn.ModbusClient().ReadFIFOQueue(address))
+	return mb.exec(slaveID, modbus.FuncCodeReadFIFOQueue, address, 0, nil, func() ([]byte, error) {
+		return mb.conn.ModbusClient().ReadFIFOQueue(address)
+	})
 }
 
 func (mb *Connection) ReadCoils(address, quantity uint16) ([]byte, error) {
@@ -227,22 +264,33 @@ func (mb *Connection) ReadFIFOQueue(address uint16) (results []byte, err error)
 	return mb.ReadFIFOQueueWithSlave(mb.slaveID, address)
 }
 
+// Schedule announces a register read operation that is executed periodically.
+// Registered ranges of all devices sharing the physical connection are coalesced into block reads.
+func (mb *Connection) Schedule(op Operation) {
+	switch op.MBMD.FuncCode {
+	case modbus.FuncCodeReadHoldingRegisters, modbus.FuncCodeReadInputRegisters:
+		mb.sched.register(mb.slaveID, op.MBMD.FuncCode, op.MBMD.OpCode, op.MBMD.ReadLen)
+	}
+}
+
 var (
 	connections = make(map[string]meters.Connection)
+	schedulers  = make(map[string]*scheduler)
 	mu          sync.Mutex
 )
 
-func registeredConnection(key string, newConn meters.Connection) meters.Connection {
+func registeredConnection(key string, newConn meters.Connection) (meters.Connection, *scheduler) {
 	mu.Lock()
 	defer mu.Unlock()
 
 	if conn, ok := connections[key]; ok {
-		return conn
+		return conn, schedulers[key]
 	}
 
 	connections[key] = newConn
+	schedulers[key] = newScheduler()
 
-	return newConn
+	return newConn, schedulers[key]
 }
 
 // ProtocolFromRTU identifies the wire format from the RTU setting
@@ -256,6 +304,7 @@ func ProtocolFromRTU(rtu *bool) Protocol {
 // NewConnection creates physical modbus device from config
 func NewConnection(uri, device, comset string, baudrate int, proto Protocol, slaveID uint8) (*Connection, error) {
 	var conn meters.Connection
+	var sched *scheduler
 
 	if device != "" && uri != "" {
 		return nil, errors.New("invalid modbus configuration: can only have either uri or device")
@@ -275,9 +324,9 @@ func NewConnection(uri, device, comset string, baudrate int, proto Protocol, sla
 		}
 
 		if proto == Ascii {
-			conn = registeredConnection(device, meters.NewASCII(device, baudrate, comset))
+			conn, sched = registeredConnection(device, meters.NewASCII(device, baudrate, comset))
 		} else {
-			conn = registeredConnection(device, meters.NewRTU(device, baudrate, comset))
+			conn, sched = registeredConnection(device, meters.NewRTU(device, baudrate, comset))
 		}
 	}
 
@@ -286,11 +335,11 @@ func NewConnection(uri, device, comset string, baudrate int, proto Protocol, sla
 
 		switch proto {
 		case Rtu:
-			conn = registeredConnection(uri, meters.NewRTUOverTCP(uri))
+			conn, sched = registeredConnection(uri, meters.NewRTUOverTCP(uri))
 		case Ascii:
-			conn = registeredConnection(uri, meters.NewASCIIOverTCP(uri))
+			conn, sched = registeredConnection(uri, meters.NewASCIIOverTCP(uri))
 		default:
-			conn = registeredConnection(uri, meters.NewTCP(uri))
+			conn, sched = registeredConnection(uri, meters.NewTCP(uri))
 		}
 	}
 
@@ -301,6 +350,7 @@ func NewConnection(uri, device, comset string, baudrate int, proto Protocol, sla
 	slaveConn := &Connection{
 		slaveID: slaveID,
 		conn:    conn,
+		sched:   sched,
 	}
 
 	return slaveConn, nil
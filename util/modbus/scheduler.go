@@ -0,0 +1,220 @@
+package modbus
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/grid-x/modbus"
+)
+
+const (
+	maxReadQuantity = 125 // maximum registers per read request
+	maxReadGap      = 8   // maximum unused registers between coalesced ranges
+)
+
+// readCacheTTL is the time register values are served from cache
+var readCacheTTL = 2 * time.Second
+
+// space is a register address space of a slave
+type space struct {
+	slaveID  uint8
+	funcCode uint8
+}
+
+// span is a range of registers
+type span struct {
+	address, quantity uint16
+}
+
+func (s span) end() int {
+	return int(s.address) + int(s.quantity)
+}
+
+func (s span) contains(o span) bool {
+	return o.address >= s.address && o.end() <= s.end()
+}
+
+// cached is the result of a register read
+type cached struct {
+	span
+	data    []byte
+	updated time.Time
+}
+
+// scheduler coalesces register reads from all devices sharing a physical connection
+// into block reads and caches the results shortly
+type scheduler struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	ranges  map[space][]span   // registered ranges
+	blocks  map[space][]span   // coalesced block reads
+	invalid map[space][]span   // blocks rejected by the device
+	cache   map[space][]cached // recent results
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{
+		now:     time.Now,
+		ranges:  make(map[space][]span),
+		blocks:  make(map[space][]span),
+		invalid: make(map[space][]span),
+		cache:   make(map[space][]cached),
+	}
+}
+
+// register adds a register range that is read periodically
+func (s *scheduler) register(slaveID, funcCode uint8, address, quantity uint16) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sp := space{slaveID, funcCode}
+	s.ranges[sp] = append(s.ranges[sp], span{address, quantity})
+	s.blocks[sp] = coalesce(s.ranges[sp])
+}
+
+// coalesce merges register ranges into minimal blocks
+func coalesce(ranges []span) []span {
+	sorted := append([]span(nil), ranges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].address < sorted[j].address
+	})
+
+	var res []span
+	for _, r := range sorted {
+		if n := len(res); n > 0 {
+			last := &res[n-1]
+			end := max(last.end(), r.end())
+
+			if int(r.address) <= last.end()+maxReadGap && end-int(last.address) <= maxReadQuantity {
+				last.quantity = uint16(end - int(last.address))
+				continue
+			}
+		}
+
+		res = append(res, r)
+	}
+
+	return res
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// invalidate drops cached values of the slave after writing
+func (s *scheduler) invalidate(slaveID uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sp := range s.cache {
+		if sp.slaveID == slaveID {
+			delete(s.cache, sp)
+		}
+	}
+}
+
+// read returns the requested registers from cache, a coalesced block read or a direct read
+func (s *scheduler) read(slaveID, funcCode uint8, address, quantity uint16, read func(address, quantity uint16) ([]byte, error)) ([]byte, error) {
+	sp := space{slaveID, funcCode}
+	req := span{address, quantity}
+
+	s.mu.Lock()
+
+	// only registered ranges are coalesced and cached
+	if !s.registered(sp, req) {
+		s.mu.Unlock()
+		return read(address, quantity)
+	}
+
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	// cached
+	valid := s.cache[sp][:0]
+	for _, c := range s.cache[sp] {
+		if now.Sub(c.updated) < readCacheTTL {
+			valid = append(valid, c)
+		}
+	}
+	s.cache[sp] = valid
+
+	for _, c := range valid {
+		if c.contains(req) {
+			return c.slice(req), nil
+		}
+	}
+
+	// coalesced block
+	if block, ok := s.block(sp, req); ok {
+		b, err := read(block.address, block.quantity)
+		if err == nil && len(b) == 2*int(block.quantity) {
+			c := cached{span: block, data: b, updated: now}
+			s.cache[sp] = append(s.cache[sp], c)
+			return c.slice(req), nil
+		}
+
+		var me *modbus.Error
+		switch {
+		case err == nil, errors.As(err, &me) && illegalRange(me):
+			// device rejects the block, fall back to direct reads
+			s.invalid[sp] = append(s.invalid[sp], block)
+		case me == nil:
+			return nil, err
+		}
+
+		// other exceptions fall back to a direct read without invalidating the block
+	}
+
+	b, err := read(address, quantity)
+	if err == nil && len(b) == 2*int(quantity) {
+		s.cache[sp] = append(s.cache[sp], cached{span: req, data: b, updated: now})
+	}
+
+	return b, err
+}
+
+// illegalRange checks if the exception rejects the requested register range
+func illegalRange(me *modbus.Error) bool {
+	return me.ExceptionCode == modbus.ExceptionCodeIllegalDataAddress || me.ExceptionCode == modbus.ExceptionCodeIllegalDataValue
+}
+
+// registered checks if the request is part of a registered range
+func (s *scheduler) registered(sp space, req span) bool {
+	for _, b := range s.blocks[sp] {
+		if b.contains(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// block returns the coalesced block containing the request if larger than the request
+func (s *scheduler) block(sp space, req span) (span, bool) {
+	for _, b := range s.blocks[sp] {
+		if !b.contains(req) || b == req {
+			continue
+		}
+
+		for _, inv := range s.invalid[sp] {
+			if inv == b {
+				return span{}, false
+			}
+		}
+
+		return b, true
+	}
+
+	return span{}, false
+}
+
+// slice returns a copy of the requested registers
+func (c cached) slice(req span) []byte {
+	start := 2 * int(req.address-c.address)
+	return append([]byte(nil), c.data[start:start+2*int(req.quantity)]...)
+}
@@ -0,0 +1,131 @@
+package modbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grid-x/modbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesce(t *testing.T) {
+	tc := []struct {
+		in, out []span
+	}{
+		{[]span{{10, 2}}, []span{{10, 2}}},
+		{[]span{{12, 2}, {10, 2}}, []span{{10, 4}}},
+		{[]span{{10, 2}, {11, 4}}, []span{{10, 5}}},
+		{[]span{{10, 2}, {20, 2}}, []span{{10, 12}}},
+		{[]span{{10, 2}, {21, 2}}, []span{{10, 2}, {21, 2}}},
+		{[]span{{0, 100}, {104, 30}}, []span{{0, 100}, {104, 30}}},
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.out, coalesce(tc.in), tc.in)
+	}
+}
+
+type registers struct {
+	reads []span
+	err   error
+}
+
+func (r *registers) read(address, quantity uint16) ([]byte, error) {
+	r.reads = append(r.reads, span{address, quantity})
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	b := make([]byte, 2*quantity)
+	for i := range b {
+		b[i] = byte(int(address)*2 + i)
+	}
+	return b, nil
+}
+
+func TestSchedulerRead(t *testing.T) {
+	now := time.Now()
+
+	s := newScheduler()
+	s.now = func() time.Time { return now }
+	s.register(1, modbus.FuncCodeReadHoldingRegisters, 10, 2)
+	s.register(1, modbus.FuncCodeReadHoldingRegisters, 14, 2)
+
+	r := new(registers)
+
+	// block read
+	b, err := s.read(1, modbus.FuncCodeReadHoldingRegisters, 14, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{28, 29, 30, 31}, b)
+	assert.Equal(t, []span{{10, 6}}, r.reads)
+
+	// cached
+	b, err = s.read(1, modbus.FuncCodeReadHoldingRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{20, 21, 22, 23}, b)
+	assert.Len(t, r.reads, 1)
+
+	// other slave is read directly
+	_, err = s.read(2, modbus.FuncCodeReadHoldingRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, span{10, 2}, r.reads[1])
+
+	// unregistered ranges are not cached
+	for i := 0; i < 2; i++ {
+		_, err = s.read(1, modbus.FuncCodeReadHoldingRegisters, 100, 2, r.read)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []span{{100, 2}, {100, 2}}, r.reads[2:])
+	r.reads = r.reads[:2]
+
+	// cache expired
+	now = now.Add(readCacheTTL)
+	_, err = s.read(1, modbus.FuncCodeReadHoldingRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, span{10, 6}, r.reads[2])
+
+	// writes invalidate the cache
+	s.invalidate(1)
+	_, err = s.read(1, modbus.FuncCodeReadHoldingRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Len(t, r.reads, 4)
+}
+
+func TestSchedulerRejectedBlock(t *testing.T) {
+	s := newScheduler()
+	s.register(1, modbus.FuncCodeReadInputRegisters, 10, 2)
+	s.register(1, modbus.FuncCodeReadInputRegisters, 14, 2)
+
+	r := &registers{err: &modbus.Error{ExceptionCode: modbus.ExceptionCodeIllegalDataAddress}}
+
+	// block and direct read
+	_, err := s.read(1, modbus.FuncCodeReadInputRegisters, 14, 2, r.read)
+	require.Error(t, err)
+	assert.Equal(t, []span{{10, 6}, {14, 2}}, r.reads)
+
+	// direct read only
+	r.err = nil
+	_, err = s.read(1, modbus.FuncCodeReadInputRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, span{10, 2}, r.reads[2])
+}
+
+func TestSchedulerBusyBlock(t *testing.T) {
+	s := newScheduler()
+	s.register(1, modbus.FuncCodeReadInputRegisters, 10, 2)
+	s.register(1, modbus.FuncCodeReadInputRegisters, 14, 2)
+
+	r := &registers{err: &modbus.Error{ExceptionCode: modbus.ExceptionCodeServerDeviceBusy}}
+
+	// block and direct read
+	_, err := s.read(1, modbus.FuncCodeReadInputRegisters, 14, 2, r.read)
+	require.Error(t, err)
+	assert.Equal(t, []span{{10, 6}, {14, 2}}, r.reads)
+
+	// block read is retried
+	r.err = nil
+	_, err = s.read(1, modbus.FuncCodeReadInputRegisters, 10, 2, r.read)
+	require.NoError(t, err)
+	assert.Equal(t, span{10, 6}, r.reads[2])
+}
@@ -0,0 +1,298 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/api/proto/pb"
+	"github.com/evcc-io/evcc/cmd/shutdown"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// SocketEnv is the environment variable containing the unix socket the plugin must listen on
+const SocketEnv = "EVCC_PLUGIN_SOCKET"
+
+var errStopped = errors.New("plugin stopped")
+
+// Capabilities advertised by plugins
+const (
+	Meter         = "meter"       // api.Meter
+	MeterEnergy   = "energy"      // api.MeterEnergy
+	PhaseCurrents = "currents"    // api.PhaseCurrents
+	PhaseVoltages = "voltages"    // api.PhaseVoltages
+	PhasePowers   = "powers"      // api.PhasePowers
+	Battery       = "battery"     // api.Battery
+	PhaseSwitcher = "phases"      // api.PhaseSwitcher
+	Identifier    = "identifier"  // api.Identifier
+	ChargeRater   = "chargerater" // api.ChargeRater
+	ChargeState   = "status"      // api.ChargeState
+	VehicleRange  = "range"       // api.VehicleRange
+	Odometer      = "odometer"    // api.VehicleOdometer
+	Climater      = "climater"    // api.VehicleClimater
+)
+
+// Config is the plugin process configuration
+type Config struct {
+	Cmd     string
+	Args    []string
+	Timeout time.Duration
+}
+
+// Client launches and supervises a plugin process and connects to its grpc service
+type Client struct {
+	pb.PluginClient
+	log     *util.Logger
+	conf    Config
+	req     *pb.ConfigureRequest
+	dir     string
+	conn    *grpc.ClientConn
+	timeout time.Duration
+
+	mu      sync.Mutex
+	caps    []string
+	cmd     *exec.Cmd
+	stopped bool
+}
+
+// NewClient starts the plugin process and configures the device of given type
+func NewClient(typ string, cc Config, config map[string]string) (*Client, error) {
+	if cc.Cmd == "" {
+		return nil, errors.New("missing cmd")
+	}
+
+	if cc.Timeout == 0 {
+		cc.Timeout = request.Timeout
+	}
+
+	dir, err := os.MkdirTemp("", "evcc-plugin")
+	if err != nil {
+		return nil, err
+	}
+
+	socket := filepath.Join(dir, "plugin.sock")
+
+	conn, err := grpc.Dial("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	c := &Client{
+		PluginClient: pb.NewPluginClient(conn),
+		log:          util.NewLogger("plugin-" + filepath.Base(cc.Cmd)),
+		conf:         cc,
+		req:          &pb.ConfigureRequest{Type: typ, Config: config},
+		dir:          dir,
+		conn:         conn,
+		timeout:      cc.Timeout,
+	}
+
+	if err := c.start(); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	if err := c.configure(); err != nil {
+		c.Close()
+		_ = c.cmd.Wait()
+		return nil, err
+	}
+
+	shutdown.Register(c.Close)
+
+	go c.supervise()
+
+	return c, nil
+}
+
+// start launches the plugin process
+func (c *Client) start() error {
+	// remove stale socket of terminated process
+	socket := filepath.Join(c.dir, "plugin.sock")
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	cmd := exec.Command(c.conf.Cmd, c.conf.Args...)
+	cmd.Env = append(os.Environ(), SocketEnv+"="+socket)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	// don't start after Close
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopped {
+		return errStopped
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	go c.logOutput(stdout, c.log.DEBUG.Println)
+	go c.logOutput(stderr, c.log.WARN.Println)
+
+	c.cmd = cmd
+
+	// connect without waiting for grpc's reconnect backoff
+	c.conn.ResetConnectBackoff()
+
+	return nil
+}
+
+func (c *Client) logOutput(r io.Reader, log func(...interface{})) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		log(scanner.Text())
+	}
+}
+
+// configure sends the device configuration and waits for the plugin to become ready
+func (c *Client) configure() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*c.timeout)
+	defer cancel()
+
+	res, err := c.Configure(ctx, c.req, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("configure: %w", err)
+	}
+
+	c.mu.Lock()
+	c.caps = res.GetCapabilities()
+	c.mu.Unlock()
+
+	c.log.DEBUG.Printf("capabilities: %v", res.GetCapabilities())
+
+	return nil
+}
+
+// supervise restarts the plugin process if it terminates
+func (c *Client) supervise() {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Second
+	bo.MaxInterval = time.Minute
+	bo.MaxElapsedTime = 0
+
+	for {
+		c.mu.Lock()
+		cmd := c.cmd
+		c.mu.Unlock()
+
+		err := cmd.Wait()
+
+		if c.isStopped() {
+			return
+		}
+
+		for {
+			c.log.ERROR.Printf("plugin terminated: %v", err)
+
+			time.Sleep(bo.NextBackOff())
+
+			if err = c.start(); err == nil {
+				if err = c.configure(); err == nil {
+					break
+				}
+
+				c.kill()
+
+				c.mu.Lock()
+				_ = c.cmd.Wait()
+				c.mu.Unlock()
+			} else if errors.Is(err, errStopped) {
+				return
+			}
+		}
+
+		bo.Reset()
+		c.log.INFO.Println("plugin restarted")
+	}
+}
+
+// isStopped checks if the client has been closed
+func (c *Client) isStopped() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stopped
+}
+
+// kill terminates the plugin process
+func (c *Client) kill() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cmd != nil && c.cmd.Process != nil {
+		_ = c.cmd.Process.Kill()
+	}
+}
+
+// Close terminates the plugin process and releases its resources
+func (c *Client) Close() {
+	c.mu.Lock()
+	c.stopped = true
+	c.mu.Unlock()
+
+	c.kill()
+	_ = c.conn.Close()
+	_ = os.RemoveAll(c.dir)
+}
+
+// Has checks if the plugin advertises the capability
+func (c *Client) Has(capability string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Contains(c.caps, capability)
+}
+
+// Context returns a context with the configured timeout
+func (c *Client) Context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+// Error converts grpc status errors to api errors
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch {
+	case s.Code() == codes.Unimplemented || s.Message() == api.ErrNotAvailable.Error():
+		return api.ErrNotAvailable
+	case s.Code() == codes.DeadlineExceeded || s.Code() == codes.Unavailable:
+		return api.ErrTimeout
+	case s.Message() == api.ErrMustRetry.Error():
+		return api.ErrMustRetry
+	default:
+		return errors.New(s.Message())
+	}
+}
@@ -0,0 +1,83 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/api/proto/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testPlugin struct {
+	pb.UnimplementedPluginServer
+	power float64
+}
+
+func (p *testPlugin) Configure(_ context.Context, req *pb.ConfigureRequest) (*pb.ConfigureReply, error) {
+	p.power, _ = strconv.ParseFloat(req.Config["power"], 64)
+	return &pb.ConfigureReply{Capabilities: []string{Meter}}, nil
+}
+
+func (p *testPlugin) CurrentPower(context.Context, *pb.Empty) (*pb.FloatValue, error) {
+	return &pb.FloatValue{Value: p.power}, nil
+}
+
+// TestHelperPlugin is executed as plugin process by TestPlugin
+func TestHelperPlugin(t *testing.T) {
+	socket := os.Getenv(SocketEnv)
+	if socket == "" {
+		t.Skip("not running as plugin")
+	}
+
+	l, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	pb.RegisterPluginServer(s, new(testPlugin))
+	_ = s.Serve(l)
+}
+
+func TestPlugin(t *testing.T) {
+	c, err := NewClient("meter", Config{
+		Cmd:  os.Args[0],
+		Args: []string{"-test.run=^TestHelperPlugin$"},
+	}, map[string]string{"power": "1000"})
+	require.NoError(t, err)
+	defer c.Close()
+
+	assert.True(t, c.Has(Meter))
+	assert.False(t, c.Has(MeterEnergy))
+
+	power, err := c.FloatGetter(c.CurrentPower)()
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, power)
+
+	_, err = c.FloatGetter(c.TotalEnergy)()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	// plugin is restarted and re-configured after termination
+	c.kill()
+
+	assert.Eventually(t, func() bool {
+		power, err := c.FloatGetter(c.CurrentPower)()
+		return err == nil && power == 1000
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestPluginNotRestartedAfterClose(t *testing.T) {
+	c, err := NewClient("meter", Config{
+		Cmd:  os.Args[0],
+		Args: []string{"-test.run=^TestHelperPlugin$"},
+	}, map[string]string{"power": "1000"})
+	require.NoError(t, err)
+
+	c.Close()
+	assert.ErrorIs(t, c.start(), errStopped)
+}
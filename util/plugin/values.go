@@ -0,0 +1,95 @@
+package plugin
+
+import (
+	"context"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/api/proto/pb"
+	"google.golang.org/grpc"
+)
+
+type getter[T any] func(context.Context, *pb.Empty, ...grpc.CallOption) (T, error)
+
+type setter[T any] func(context.Context, T, ...grpc.CallOption) (*pb.Empty, error)
+
+func get[T any](c *Client, fun getter[T]) (T, error) {
+	ctx, cancel := c.Context()
+	defer cancel()
+
+	res, err := fun(ctx, new(pb.Empty))
+	return res, Error(err)
+}
+
+func set[T any](c *Client, fun setter[T], val T) error {
+	ctx, cancel := c.Context()
+	defer cancel()
+
+	_, err := fun(ctx, val)
+	return Error(err)
+}
+
+// FloatGetter wraps a float rpc as getter
+func (c *Client) FloatGetter(fun getter[*pb.FloatValue]) func() (float64, error) {
+	return func() (float64, error) {
+		res, err := get(c, fun)
+		return res.GetValue(), err
+	}
+}
+
+// IntGetter wraps an int rpc as getter
+func (c *Client) IntGetter(fun getter[*pb.IntValue]) func() (int64, error) {
+	return func() (int64, error) {
+		res, err := get(c, fun)
+		return res.GetValue(), err
+	}
+}
+
+// BoolGetter wraps a bool rpc as getter
+func (c *Client) BoolGetter(fun getter[*pb.BoolValue]) func() (bool, error) {
+	return func() (bool, error) {
+		res, err := get(c, fun)
+		return res.GetValue(), err
+	}
+}
+
+// StringGetter wraps a string rpc as getter
+func (c *Client) StringGetter(fun getter[*pb.StringValue]) func() (string, error) {
+	return func() (string, error) {
+		res, err := get(c, fun)
+		return res.GetValue(), err
+	}
+}
+
+// PhaseGetter wraps a phase values rpc as getter
+func (c *Client) PhaseGetter(fun getter[*pb.PhaseValues]) func() (float64, float64, float64, error) {
+	return func() (float64, float64, float64, error) {
+		res, err := get(c, fun)
+		return res.GetL1(), res.GetL2(), res.GetL3(), err
+	}
+}
+
+// StatusGetter wraps the status rpc as getter
+func (c *Client) StatusGetter() func() (api.ChargeStatus, error) {
+	g := c.StringGetter(c.Status)
+	return func() (api.ChargeStatus, error) {
+		res, err := g()
+		if err != nil {
+			return api.StatusNone, err
+		}
+		return api.ChargeStatus(res), nil
+	}
+}
+
+// BoolSetter wraps a bool rpc as setter
+func (c *Client) BoolSetter(fun setter[*pb.BoolValue]) func(bool) error {
+	return func(val bool) error {
+		return set(c, fun, &pb.BoolValue{Value: val})
+	}
+}
+
+// IntSetter wraps an int rpc as setter
+func (c *Client) IntSetter(fun setter[*pb.IntValue]) func(int64) error {
+	return func(val int64) error {
+		return set(c, fun, &pb.IntValue{Value: val})
+	}
+}
@@ -34,13 +34,20 @@ func (l *Redactor) Redact(redact ...string) {
 	}
 }
 
-func (l *Redactor) Write(p []byte) (n int, err error) {
+// Redacted returns p with all redaction items replaced
+func (l *Redactor) Redacted(p []byte) []byte {
 	l.mu.Lock()
+	defer l.mu.Unlock()
+
 	for _, s := range l.redact {
 		p = bytes.ReplaceAll(p, []byte(s), []byte(RedactReplacement))
 	}
-	l.mu.Unlock()
-	return os.Stdout.Write(p)
+
+	return p
+}
+
+func (l *Redactor) Write(p []byte) (n int, err error) {
+	return os.Stdout.Write(l.Redacted(p))
 }
 
 // RedactDefaultHook expands a redaction item to include URL encoding
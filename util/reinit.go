@@ -0,0 +1,60 @@
+package util
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff"
+)
+
+const (
+	reinitInitialInterval = time.Minute
+	reinitMaxInterval     = 30 * time.Minute
+	retryTimeout          = 30 * time.Second
+)
+
+// Retry runs the device factory with exponential backoff until it succeeds, fails with an error
+// not accepted by retry, or the retry timeout has elapsed.
+func Retry[T any](log *Logger, factory func() (T, error), retry func(error) bool) (T, error) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = time.Second
+	bo.MaxElapsedTime = retryTimeout
+
+	var dev T
+	err := backoff.Retry(func() error {
+		var err error
+		if dev, err = factory(); err != nil {
+			if !retry(err) {
+				return backoff.Permanent(err)
+			}
+
+			log.DEBUG.Printf("initialization failed: %v", err)
+		}
+
+		return err
+	}, bo)
+
+	return dev, err
+}
+
+// Reinit re-runs the device factory in the background with exponential backoff until it succeeds.
+// The created device is passed to done.
+func Reinit[T any](log *Logger, factory func() (T, error), done func(T)) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = reinitInitialInterval
+	bo.MaxInterval = reinitMaxInterval
+	bo.MaxElapsedTime = 0
+
+	go func() {
+		for {
+			time.Sleep(bo.NextBackOff())
+
+			dev, err := factory()
+			if err == nil {
+				done(dev)
+				return
+			}
+
+			log.DEBUG.Printf("re-initialization failed: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetry(t *testing.T) {
+	log := NewLogger("foo")
+	errTemporary := errors.New("temporary")
+	errPermanent := errors.New("permanent")
+
+	retryable := func(err error) bool {
+		return errors.Is(err, errTemporary)
+	}
+
+	var calls int
+	res, err := Retry(log, func() (int, error) {
+		if calls++; calls < 2 {
+			return 0, errTemporary
+		}
+		return 42, nil
+	}, retryable)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, res)
+	assert.Equal(t, 2, calls)
+
+	// permanent errors are not retried
+	calls = 0
+	_, err = Retry(log, func() (int, error) {
+		calls++
+		return 0, errPermanent
+	}, retryable)
+	assert.ErrorIs(t, err, errPermanent)
+	assert.Equal(t, 1, calls)
+}
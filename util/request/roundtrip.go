@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/trace"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -50,11 +51,11 @@ func init() {
 	prometheus.MustRegister(reqMetric, resMetric)
 }
 
-// NewTripper creates a logging roundtrip handler
+// NewTripper creates a logging roundtrip handler that supports recording and replaying traces
 func NewTripper(log *util.Logger, base http.RoundTripper) http.RoundTripper {
 	tripper := &roundTripper{
 		log:  log,
-		base: base,
+		base: trace.Transport(base),
 	}
 
 	return tripper
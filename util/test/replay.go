@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util/trace"
+)
+
+// Replay replaces device I/O with the recorded trace file for the duration of the test
+func Replay(t *testing.T, file string) {
+	t.Helper()
+
+	if _, err := trace.Replay(file); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(trace.Stop)
+}
@@ -0,0 +1,114 @@
+package trace
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// Transport wraps the base round tripper for recording and replaying http requests
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+// requestKey identifies the request independent of the redaction in effect
+func requestKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + req.URL.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// readBody reads and restores the body
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(b))
+
+	return b, err
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec, p := active()
+	if p != nil {
+		return p.http(req)
+	}
+
+	if rec == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	e := Entry{
+		Type:    HTTP,
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Key:     requestKey(req),
+		Request: string(reqBody),
+	}
+
+	resp, err := t.base.RoundTrip(req)
+
+	if err == nil {
+		var resBody []byte
+		if resBody, err = readBody(&resp.Body); err != nil {
+			return nil, err
+		}
+
+		e.Status = resp.StatusCode
+		e.ContentType = resp.Header.Get("Content-Type")
+		e.Response = string(resBody)
+	}
+
+	e.Err = errString(err)
+	rec.Record(e)
+
+	return resp, err
+}
+
+func (p *Player) http(req *http.Request) (*http.Response, error) {
+	key, url := requestKey(req), redact(req.URL.String())
+
+	// entries without key, e.g. hand-written test data, match the redacted url
+	e, err := p.next(func(e Entry) bool {
+		return e.Type == HTTP && (e.Key == key || e.Key == "" && e.Method == req.Method && e.URL == url)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %s", err, req.Method, url)
+	}
+
+	if err := p.result(e); err != nil {
+		return nil, err
+	}
+
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status)),
+		StatusCode:    e.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewBufferString(e.Response)),
+		ContentLength: int64(len(e.Response)),
+		Request:       req,
+	}
+
+	if e.ContentType != "" {
+		resp.Header.Set("Content-Type", e.ContentType)
+	}
+
+	return resp, nil
+}
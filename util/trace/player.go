@@ -0,0 +1,204 @@
+package trace
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Player replays recorded trace entries
+type Player struct {
+	mu      sync.Mutex
+	entries []Entry
+	used    []bool
+	pos     int // entries before pos have been replayed
+	subs    []*subscription
+}
+
+// subscription receives replayed mqtt messages of a topic
+type subscription struct {
+	topic string
+	fn    func(string)
+	next  int // index of next entry to deliver
+}
+
+// Load reads trace entries from the reader
+func Load(r io.Reader) (*Player, error) {
+	p := new(Player)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16<<20)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("trace: %w", err)
+		}
+
+		p.entries = append(p.entries, e)
+	}
+
+	p.used = make([]bool, len(p.entries))
+
+	return p, scanner.Err()
+}
+
+// Replay replaces device I/O with the entries recorded in the trace file
+func Replay(file string) (*Player, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := Load(f)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Start()
+
+	return p, nil
+}
+
+// Start activates replay of the player's entries
+func (p *Player) Start() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	player = p
+	recorder = nil
+}
+
+// next returns the first unused matching entry in recording order.
+// Once all matching entries are used, the last one is repeated.
+// Messages received before the entry are delivered before returning.
+func (p *Player) next(match func(Entry) bool) (Entry, error) {
+	p.mu.Lock()
+
+	i := p.find(match)
+	if i < 0 {
+		p.mu.Unlock()
+		return Entry{}, errors.New("trace: no matching entry")
+	}
+
+	deliver := p.advance(i + 1)
+	p.mu.Unlock()
+
+	deliver()
+
+	return p.entries[i], nil
+}
+
+// find returns the index of the first unused or the last used matching entry
+func (p *Player) find(match func(Entry) bool) int {
+	last := -1
+	for i, e := range p.entries {
+		if !match(e) {
+			continue
+		}
+
+		if !p.used[i] {
+			p.used[i] = true
+			return i
+		}
+
+		last = i
+	}
+
+	return last
+}
+
+// advance moves the replay position and returns a function delivering the messages due
+func (p *Player) advance(pos int) func() {
+	if pos > p.pos {
+		p.pos = pos
+	}
+
+	var deliver []func()
+	for _, s := range p.subs {
+		for _, payload := range p.due(s) {
+			fn, payload := s.fn, payload
+			deliver = append(deliver, func() { fn(payload) })
+		}
+	}
+
+	return func() {
+		for _, fn := range deliver {
+			fn()
+		}
+	}
+}
+
+// due returns the subscription's messages received before the replay position
+func (p *Player) due(s *subscription) []string {
+	var res []string
+	for ; s.next < p.pos; s.next++ {
+		if e := p.entries[s.next]; e.Type == MQTT && e.Op == Receive && e.Topic == s.topic {
+			res = append(res, e.Payload)
+		}
+	}
+	return res
+}
+
+func (p *Player) result(e Entry) error {
+	if e.Err != "" {
+		return errors.New(e.Err)
+	}
+	return nil
+}
+
+func (p *Player) modbus(req Entry) ([]byte, error) {
+	e, err := p.next(func(e Entry) bool {
+		return e.Type == Modbus && e.Op == req.Op && e.Slave == req.Slave && e.Func == req.Func &&
+			e.Address == req.Address && e.Quantity == req.Quantity && (req.Op == Read || e.Value == req.Value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: modbus %s slave %d func %d address %d quantity %d", err, req.Op, req.Slave, req.Func, req.Address, req.Quantity)
+	}
+
+	res, err := hex.DecodeString(e.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, p.result(e)
+}
+
+// subscribe delivers the topic's messages in recorded order as the replay advances.
+// If no message is due yet, the next one is delivered immediately as initial value.
+func (p *Player) subscribe(topic string, fn func(string)) {
+	p.mu.Lock()
+
+	s := &subscription{topic: topic, fn: fn}
+	p.subs = append(p.subs, s)
+
+	msgs := p.due(s)
+	for i := p.pos; i < len(p.entries) && len(msgs) == 0; i++ {
+		if e := p.entries[i]; e.Type == MQTT && e.Op == Receive && e.Topic == topic {
+			msgs, s.next = append(msgs, e.Payload), i+1
+		}
+	}
+
+	p.mu.Unlock()
+
+	for _, payload := range msgs {
+		fn(payload)
+	}
+}
+
+// publish advances the replay past the matching published message
+func (p *Player) publish(topic, payload string) {
+	_, _ = p.next(func(e Entry) bool {
+		return e.Type == MQTT && e.Op == Publish && e.Topic == topic && e.Payload == payload
+	})
+}
@@ -0,0 +1,93 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// secretNames matches complete json keys and form parameter names of sensitive values.
+// Key and code must end the name to not match e.g. errorCode or keyId.
+const secretNames = `(?:[\w.-]*(?:secret|token|password|session|cookie)[\w.-]*|[\w.-]*key|code(?:[_.-]?verifier)?|vin)`
+
+// secrets matches values of json keys and form parameters with sensitive names
+var secrets = regexp.MustCompile(`(?i)("(?:` + secretNames + `|serial)"\s*:\s*)"[^"]*"|((?:^|[?&;\s])` + secretNames + `=)[^&"\s]*`)
+
+// Recorder writes redacted trace entries as json lines
+type Recorder struct {
+	mu    sync.Mutex
+	w     io.Writer
+	clock func() time.Time
+}
+
+// Record starts recording device I/O to the writer
+func Record(w io.Writer) *Recorder {
+	rec := &Recorder{
+		w:     w,
+		clock: time.Now,
+	}
+
+	mu.Lock()
+	recorder = rec
+	mu.Unlock()
+
+	return rec
+}
+
+// Stop stops recording and replaying device I/O
+func Stop() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	recorder = nil
+	player = nil
+}
+
+// redact removes secrets registered with any logger and well-known secrets
+func redact(s string) string {
+	b := util.RedactAll([]byte(s))
+
+	return string(secrets.ReplaceAllFunc(b, func(m []byte) []byte {
+		sub := secrets.FindSubmatch(m)
+		if len(sub[1]) > 0 {
+			return []byte(fmt.Sprintf(`%s"%s"`, sub[1], util.RedactReplacement))
+		}
+		return append(sub[2], util.RedactReplacement...)
+	}))
+}
+
+// Record writes the redacted entry
+func (r *Recorder) Record(e Entry) {
+	e.Time = r.clock()
+	e.URL = redact(e.URL)
+	e.Request = redact(e.Request)
+	e.Response = redact(e.Response)
+	e.Payload = redact(e.Payload)
+	e.Err = redact(e.Err)
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, _ = r.w.Write(append(b, '\n'))
+}
+
+func toString(payload interface{}) string {
+	switch v := payload.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
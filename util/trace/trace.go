@@ -0,0 +1,136 @@
+// Package trace records device I/O to a redacted trace file and replays it,
+// allowing device issues to be reproduced without access to the hardware.
+package trace
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+const (
+	HTTP   = "http"
+	Modbus = "modbus"
+	MQTT   = "mqtt"
+
+	// operations
+	Read    = "read"
+	Write   = "write"
+	Publish = "publish"
+	Receive = "receive"
+)
+
+// Entry is a single recorded device interaction
+type Entry struct {
+	Time time.Time `json:"time"`
+	Type string    `json:"type"`          // http, modbus or mqtt
+	Op   string    `json:"op,omitempty"`  // modbus read/write, mqtt publish/receive
+	Err  string    `json:"err,omitempty"` // error returned
+
+	// http
+	Method      string `json:"method,omitempty"`
+	URL         string `json:"url,omitempty"`         // redacted url
+	Key         string `json:"key,omitempty"`         // hash of the unredacted request for matching
+	Request     string `json:"request,omitempty"`     // request body
+	Status      int    `json:"status,omitempty"`      // response status
+	ContentType string `json:"contentType,omitempty"` // response content type
+	Response    string `json:"response,omitempty"`    // response body
+
+	// modbus
+	Slave    uint8  `json:"slave,omitempty"`
+	Func     uint8  `json:"func,omitempty"`
+	Address  uint16 `json:"address,omitempty"`
+	Quantity uint16 `json:"quantity,omitempty"`
+	Value    string `json:"value,omitempty"`  // hex encoded data written
+	Result   string `json:"result,omitempty"` // hex encoded data read
+
+	// mqtt
+	Topic   string `json:"topic,omitempty"`
+	Payload string `json:"payload,omitempty"`
+}
+
+var (
+	mu       sync.Mutex
+	recorder *Recorder
+	player   *Player
+)
+
+// active returns the current recorder and player
+func active() (*Recorder, *Player) {
+	mu.Lock()
+	defer mu.Unlock()
+	return recorder, player
+}
+
+// Replaying returns true if device I/O is replayed instead of executed
+func Replaying() bool {
+	_, p := active()
+	return p != nil
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// ModbusOp records or replays a modbus operation executed by fun
+func ModbusOp(slave, funcCode uint8, address, quantity uint16, value []byte, fun func() ([]byte, error)) ([]byte, error) {
+	e := Entry{
+		Type:     Modbus,
+		Op:       Read,
+		Slave:    slave,
+		Func:     funcCode,
+		Address:  address,
+		Quantity: quantity,
+		Value:    hex.EncodeToString(value),
+	}
+
+	if value != nil {
+		e.Op = Write
+	}
+
+	rec, p := active()
+	if p != nil {
+		return p.modbus(e)
+	}
+
+	res, err := fun()
+
+	if rec != nil {
+		e.Result = hex.EncodeToString(res)
+		e.Err = errString(err)
+		rec.Record(e)
+	}
+
+	return res, err
+}
+
+// MqttPublish records or replays a published mqtt message
+func MqttPublish(topic string, payload interface{}) {
+	rec, p := active()
+	if p != nil {
+		p.publish(topic, redact(toString(payload)))
+		return
+	}
+
+	if rec != nil {
+		rec.Record(Entry{Type: MQTT, Op: Publish, Topic: topic, Payload: toString(payload)})
+	}
+}
+
+// MqttReceive records a received mqtt message
+func MqttReceive(topic, payload string) {
+	if rec, _ := active(); rec != nil {
+		rec.Record(Entry{Type: MQTT, Op: Receive, Topic: topic, Payload: payload})
+	}
+}
+
+// MqttSubscribe delivers the replayed messages received on the topic in recorded order.
+// Messages are delivered once the device I/O recorded before them has been replayed.
+func MqttSubscribe(topic string, fn func(payload string)) {
+	if _, p := active(); p != nil {
+		p.subscribe(topic, fn)
+	}
+}
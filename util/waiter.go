@@ -2,11 +2,37 @@ package util
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var waitInitialTimeout = 10 * time.Second
 
+var (
+	updated   = make(chan struct{}, 1) // signals that a notifying waiter has received data
+	notify    atomic.Bool              // waiters created while set are notifying
+	notifying atomic.Bool              // any notifying waiter has been created
+)
+
+// NotifyUpdates wraps the device factory. Waiters created by the factory signal received data on the Updated channel.
+// Devices must be created sequentially to prevent other devices' waiters from signalling.
+func NotifyUpdates[T any](factory func() (T, error)) func() (T, error) {
+	return func() (T, error) {
+		notify.Store(true)
+		defer notify.Store(false)
+		return factory()
+	}
+}
+
+// Updated returns a channel signalling that push-based providers of devices created by NotifyUpdates
+// have received fresh data. It returns nil if no such provider exists.
+func Updated() <-chan struct{} {
+	if !notifying.Load() {
+		return nil
+	}
+	return updated
+}
+
 // Waiter provides monitoring of receive timeouts and reception of initial value
 type Waiter struct {
 	mu      sync.Mutex
@@ -14,15 +40,23 @@ type Waiter struct {
 	updated time.Time
 	timeout time.Duration
 	initial chan bool
+	notify  bool
 }
 
 // NewWaiter creates new waiter
 func NewWaiter(timeout time.Duration, logInitialWait func()) *Waiter {
-	return &Waiter{
+	p := &Waiter{
 		log:     logInitialWait,
 		timeout: timeout,
 		initial: make(chan bool),
+		notify:  notify.Load(),
 	}
+
+	if p.notify {
+		notifying.Store(true)
+	}
+
+	return p
 }
 
 // Update is called when client has received data. Update resets the timeout counter.
@@ -37,6 +71,13 @@ func (p *Waiter) Update() {
 	default:
 		close(p.initial)
 	}
+
+	if p.notify {
+		select {
+		case updated <- struct{}{}:
+		default:
+		}
+	}
 }
 
 // Overdue waits for initial update and returns the duration since the last update
@@ -62,3 +62,34 @@ func TestWaiterUpdateNotReceived(t *testing.T) {
 		t.Errorf("expected >%v, got %v", 2*testTimeout, elapsed)
 	}
 }
+
+func TestWaiterUpdated(t *testing.T) {
+	w, _ := NotifyUpdates(func() (*Waiter, error) {
+		return NewWaiter(0, func() {}), nil
+	})()
+
+	// waiters created outside of NotifyUpdates don't signal
+	other := NewWaiter(0, func() {})
+
+	// drain
+	select {
+	case <-Updated():
+	default:
+	}
+
+	other.Update()
+
+	select {
+	case <-Updated():
+		t.Error("unexpected update signal")
+	default:
+	}
+
+	w.Update()
+
+	select {
+	case <-Updated():
+	default:
+		t.Error("expected update signal")
+	}
+}
@@ -0,0 +1,67 @@
+package vehicle
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/plugin"
+)
+
+// Plugin is an api.Vehicle implementation provided by an out-of-process plugin
+type Plugin struct {
+	*embed
+	socG func() (float64, error)
+}
+
+func init() {
+	registry.Add("plugin", NewPluginFromConfig)
+}
+
+// NewPluginFromConfig creates a new vehicle
+func NewPluginFromConfig(other map[string]interface{}) (api.Vehicle, error) {
+	var cc struct {
+		embed         `mapstructure:",squash"`
+		plugin.Config `mapstructure:",squash"`
+		Other         map[string]string `mapstructure:",remain"`
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	client, err := plugin.NewClient("vehicle", cc.Config, cc.Other)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Plugin{
+		embed: &cc.embed,
+		socG:  client.FloatGetter(client.Soc),
+	}
+
+	var status func() (api.ChargeStatus, error)
+	if client.Has(plugin.ChargeState) {
+		status = client.StatusGetter()
+	}
+
+	var rng func() (int64, error)
+	if client.Has(plugin.VehicleRange) {
+		rng = client.IntGetter(client.Range)
+	}
+
+	var odo func() (float64, error)
+	if client.Has(plugin.Odometer) {
+		odo = client.FloatGetter(client.Odometer)
+	}
+
+	var climater func() (bool, error)
+	if client.Has(plugin.Climater) {
+		climater = client.BoolGetter(client.Climater)
+	}
+
+	return decorateVehicle(v, status, rng, odo, climater, nil, nil, nil), nil
+}
+
+// Soc implements the api.Vehicle interface
+func (v *Plugin) Soc() (float64, error) {
+	return v.socG()
+}
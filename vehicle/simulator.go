@@ -0,0 +1,60 @@
+package vehicle
+
+import (
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/simulator"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Simulator is a simulated vehicle charged by simulated chargers
+type Simulator struct {
+	*embed
+	*simulator.Vehicle
+}
+
+func init() {
+	registry.Add("simulator", NewSimulatorFromConfig)
+}
+
+// NewSimulatorFromConfig creates a simulated vehicle from generic config
+func NewSimulatorFromConfig(other map[string]interface{}) (api.Vehicle, error) {
+	cc := struct {
+		embed        `mapstructure:",squash"`
+		Model        string // shared simulation model
+		ID           string // vehicle id referenced by simulated chargers
+		Soc          float64
+		MaxCurrent   float64
+		TaperSoc     float64
+		Efficiency   float64
+		Consumption  float64
+		Disconnected bool
+	}{
+		embed: embed{
+			Capacity_: 50,
+			Phases_:   3,
+		},
+		Soc: 30,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	sim := simulator.Instance(cc.Model).AddVehicle(cc.ID, simulator.VehicleConfig{
+		Capacity:     cc.Capacity_,
+		Soc:          cc.Soc,
+		Phases:       cc.Phases_,
+		MaxCurrent:   cc.MaxCurrent,
+		TaperSoc:     cc.TaperSoc,
+		Efficiency:   cc.Efficiency,
+		Consumption:  cc.Consumption,
+		Disconnected: cc.Disconnected,
+	})
+
+	v := &Simulator{
+		embed:   &cc.embed,
+		Vehicle: sim,
+	}
+
+	return v, nil
+}
@@ -70,6 +70,32 @@ const (
 	ActionChargeStop  = "Stop"
 )
 
+// ActionUpdateSettings updates charge settings
+const ActionUpdateSettings = "UpdateSettings"
+
+// UpdateSettings updates the charging target soc
+func (v *API) UpdateSettings(vin string, targetSoc int64) error {
+	var res map[string]interface{}
+	uri := fmt.Sprintf("%s/v1/%s/operation-requests?vin=%s", BaseURI, ActionCharge, vin)
+
+	data := struct {
+		Typ              string `json:"type"`
+		ChargingSettings struct {
+			TargetStateOfChargeInPercent int64 `json:"targetStateOfChargeInPercent"`
+		} `json:"chargingSettings"`
+	}{
+		Typ: ActionUpdateSettings,
+	}
+	data.ChargingSettings.TargetStateOfChargeInPercent = targetSoc
+
+	req, err := request.New(http.MethodPost, uri, request.MarshalJSON(data), request.JSONEncoding)
+	if err == nil {
+		err = v.DoJSON(req, &res)
+	}
+
+	return err
+}
+
 // Action executes a vehicle action
 func (v *API) Action(vin, action, value string) error {
 	var res map[string]interface{}
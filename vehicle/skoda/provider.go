@@ -13,6 +13,7 @@ type Provider struct {
 	chargerG  func() (ChargerResponse, error)
 	settingsG func() (SettingsResponse, error)
 	action    func(action, value string) error
+	settingsS func(targetSoc int64) error
 }
 
 // NewProvider creates a vehicle api provider
@@ -33,6 +34,9 @@ func NewProvider(api *API, vin string, cache time.Duration) *Provider {
 		action: func(action, value string) error {
 			return api.Action(vin, action, value)
 		},
+		settingsS: func(targetSoc int64) error {
+			return api.UpdateSettings(vin, targetSoc)
+		},
 	}
 	return impl
 }
@@ -137,6 +141,13 @@ func (v *Provider) TargetSoc() (float64, error) {
 	return 0, err
 }
 
+var _ api.SocLimitSetter = (*Provider)(nil)
+
+// SetTargetSoc implements the api.SocLimitSetter interface
+func (v *Provider) SetTargetSoc(soc int64) error {
+	return v.settingsS(soc)
+}
+
 var _ api.VehicleChargeController = (*Provider)(nil)
 
 // StartCharge implements the api.VehicleChargeController interface
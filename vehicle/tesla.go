@@ -2,6 +2,7 @@ package vehicle
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/bogosj/tesla"
@@ -174,6 +175,16 @@ func (v *Tesla) FinishTime() (time.Time, error) {
 
 // TODO api.Climater implementation has been removed as it drains battery. Re-check at a later time.
 
+var _ api.VehicleClimateController = (*Tesla)(nil)
+
+// Climatize implements the api.VehicleClimateController interface
+func (v *Tesla) Climatize(enable bool) error {
+	if enable {
+		return v.vehicle.StartAirConditioning()
+	}
+	return v.vehicle.StopAirConditioning()
+}
+
 var _ api.VehiclePosition = (*Tesla)(nil)
 
 // Position implements the api.VehiclePosition interface
@@ -198,6 +209,14 @@ func (v *Tesla) TargetSoc() (float64, error) {
 	return 0, err
 }
 
+var _ api.SocLimitSetter = (*Tesla)(nil)
+
+// SetTargetSoc implements the api.SocLimitSetter interface
+func (v *Tesla) SetTargetSoc(soc int64) error {
+	// charge limit must be within 50..100%
+	return v.vehicle.SetChargeLimit(int(math.Max(50, math.Min(100, float64(soc)))))
+}
+
 var _ api.CurrentLimiter = (*Tesla)(nil)
 
 // StartCharge implements the api.VehicleChargeController interface
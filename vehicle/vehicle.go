@@ -8,7 +8,7 @@ import (
 	"github.com/evcc-io/evcc/util"
 )
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateVehicle -b api.Vehicle -t "api.ChargeState,Status,func() (api.ChargeStatus, error)" -t "api.VehicleRange,Range,func() (int64, error)" -t "api.VehicleOdometer,Odometer,func() (float64, error)" -t "api.VehicleClimater,Climater,func() (bool, error)"
+//go:generate go run ../cmd/tools/decorate.go -f decorateVehicle -b api.Vehicle -t "api.ChargeState,Status,func() (api.ChargeStatus, error)" -t "api.VehicleRange,Range,func() (int64, error)" -t "api.VehicleOdometer,Odometer,func() (float64, error)" -t "api.VehicleClimater,Climater,func() (bool, error)" -t "api.SocLimiter,TargetSoc,func() (float64, error)" -t "api.SocLimitSetter,SetTargetSoc,func(soc int64) error" -t "api.VehicleClimateController,Climatize,func(enable bool) error"
 
 // Vehicle is an api.Vehicle implementation with configurable getters and setters.
 type Vehicle struct {
@@ -24,12 +24,15 @@ func init() {
 // NewConfigurableFromConfig creates a new Vehicle
 func NewConfigurableFromConfig(other map[string]interface{}) (api.Vehicle, error) {
 	var cc struct {
-		embed    `mapstructure:",squash"`
-		Soc      provider.Config
-		Status   *provider.Config
-		Range    *provider.Config
-		Odometer *provider.Config
-		Climater *provider.Config
+		embed       `mapstructure:",squash"`
+		Soc         provider.Config
+		Status      *provider.Config
+		Range       *provider.Config
+		Odometer    *provider.Config
+		Climater    *provider.Config
+		Climatize   *provider.Config
+		LimitSoc    *provider.Config
+		SetLimitSoc *provider.Config
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
@@ -86,7 +89,34 @@ func NewConfigurableFromConfig(other map[string]interface{}) (api.Vehicle, error
 		climater = climateG
 	}
 
-	res := decorateVehicle(v, status, rng, odo, climater)
+	// decorate vehicle with climate control
+	var climatize func(bool) error
+	if cc.Climatize != nil {
+		climatize, err = provider.NewBoolSetterFromConfig("climatize", *cc.Climatize)
+		if err != nil {
+			return nil, fmt.Errorf("climatize: %w", err)
+		}
+	}
+
+	// decorate vehicle with soc limit
+	var limitSoc func() (float64, error)
+	if cc.LimitSoc != nil {
+		limitSoc, err = provider.NewFloatGetterFromConfig(*cc.LimitSoc)
+		if err != nil {
+			return nil, fmt.Errorf("limitSoc: %w", err)
+		}
+	}
+
+	// decorate vehicle with soc limit setter
+	var setLimitSoc func(int64) error
+	if cc.SetLimitSoc != nil {
+		setLimitSoc, err = provider.NewIntSetterFromConfig("limitsoc", *cc.SetLimitSoc)
+		if err != nil {
+			return nil, fmt.Errorf("setLimitSoc: %w", err)
+		}
+	}
+
+	res := decorateVehicle(v, status, rng, odo, climater, limitSoc, setLimitSoc, climatize)
 
 	return res, nil
 }
@@ -6,12 +6,12 @@ import (
 	"github.com/evcc-io/evcc/api"
 )
 
-func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, error), vehicleRange func() (int64, error), vehicleOdometer func() (float64, error), vehicleClimater func() (bool, error)) api.Vehicle {
+func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, error), vehicleRange func() (int64, error), vehicleOdometer func() (float64, error), vehicleClimater func() (bool, error), socLimiter func() (float64, error), socLimitSetter func(soc int64) error, vehicleClimateController func(enable bool) error) api.Vehicle {
 	switch {
-	case chargeState == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
 		return base
 
-	case chargeState != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -22,7 +22,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleRange
@@ -33,7 +33,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -48,7 +48,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleOdometer
@@ -59,7 +59,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -74,7 +74,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleOdometer
@@ -89,7 +89,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -108,7 +108,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleClimater
@@ -119,7 +119,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -134,7 +134,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleClimater
@@ -149,7 +149,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -168,7 +168,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleClimater
@@ -183,7 +183,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -202,7 +202,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.VehicleClimater
@@ -221,7 +221,7 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 			},
 		}
 
-	case chargeState != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
 		return &struct {
 			api.Vehicle
 			api.ChargeState
@@ -243,6 +243,2454 @@ func decorateVehicle(base api.Vehicle, chargeState func() (api.ChargeStatus, err
 				vehicleRange: vehicleRange,
 			},
 		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController == nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter == nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter == nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater == nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer == nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange == nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
+
+	case chargeState != nil && socLimitSetter != nil && socLimiter != nil && vehicleClimateController != nil && vehicleClimater != nil && vehicleOdometer != nil && vehicleRange != nil:
+		return &struct {
+			api.Vehicle
+			api.ChargeState
+			api.SocLimitSetter
+			api.SocLimiter
+			api.VehicleClimateController
+			api.VehicleClimater
+			api.VehicleOdometer
+			api.VehicleRange
+		}{
+			Vehicle: base,
+			ChargeState: &decorateVehicleChargeStateImpl{
+				chargeState: chargeState,
+			},
+			SocLimitSetter: &decorateVehicleSocLimitSetterImpl{
+				socLimitSetter: socLimitSetter,
+			},
+			SocLimiter: &decorateVehicleSocLimiterImpl{
+				socLimiter: socLimiter,
+			},
+			VehicleClimateController: &decorateVehicleVehicleClimateControllerImpl{
+				vehicleClimateController: vehicleClimateController,
+			},
+			VehicleClimater: &decorateVehicleVehicleClimaterImpl{
+				vehicleClimater: vehicleClimater,
+			},
+			VehicleOdometer: &decorateVehicleVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehicleRange: &decorateVehicleVehicleRangeImpl{
+				vehicleRange: vehicleRange,
+			},
+		}
 	}
 
 	return nil
@@ -256,6 +2704,30 @@ func (impl *decorateVehicleChargeStateImpl) Status() (api.ChargeStatus, error) {
 	return impl.chargeState()
 }
 
+type decorateVehicleSocLimitSetterImpl struct {
+	socLimitSetter func(soc int64) error
+}
+
+func (impl *decorateVehicleSocLimitSetterImpl) SetTargetSoc(soc int64) error {
+	return impl.socLimitSetter(soc)
+}
+
+type decorateVehicleSocLimiterImpl struct {
+	socLimiter func() (float64, error)
+}
+
+func (impl *decorateVehicleSocLimiterImpl) TargetSoc() (float64, error) {
+	return impl.socLimiter()
+}
+
+type decorateVehicleVehicleClimateControllerImpl struct {
+	vehicleClimateController func(enable bool) error
+}
+
+func (impl *decorateVehicleVehicleClimateControllerImpl) Climatize(enable bool) error {
+	return impl.vehicleClimateController(enable)
+}
+
 type decorateVehicleVehicleClimaterImpl struct {
 	vehicleClimater func() (bool, error)
 }
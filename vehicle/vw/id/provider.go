@@ -11,8 +11,9 @@ import (
 
 // Provider is an api.Vehicle implementation for VW ID cars
 type Provider struct {
-	statusG func() (Status, error)
-	action  func(action, value string) error
+	statusG   func() (Status, error)
+	action    func(action, value string) error
+	settingsS func(targetSoc int64) error
 }
 
 // NewProvider creates a vehicle api provider
@@ -24,6 +25,9 @@ func NewProvider(api *API, vin string, cache time.Duration) *Provider {
 		action: func(action, value string) error {
 			return api.Action(vin, action, value)
 		},
+		settingsS: func(targetSoc int64) error {
+			return api.ChargeSettings(vin, targetSoc)
+		},
 	}
 	return impl
 }
@@ -160,6 +164,13 @@ func (v *Provider) TargetSoc() (float64, error) {
 	return 0, err
 }
 
+var _ api.SocLimitSetter = (*Provider)(nil)
+
+// SetTargetSoc implements the api.SocLimitSetter interface
+func (v *Provider) SetTargetSoc(soc int64) error {
+	return v.settingsS(soc)
+}
+
 var _ api.VehicleChargeController = (*Provider)(nil)
 
 // StartCharge implements the api.VehicleChargeController interface
@@ -171,3 +182,13 @@ func (v *Provider) StartCharge() error {
 func (v *Provider) StopCharge() error {
 	return v.action(ActionCharge, ActionChargeStop)
 }
+
+var _ api.VehicleClimateController = (*Provider)(nil)
+
+// Climatize implements the api.VehicleClimateController interface
+func (v *Provider) Climatize(enable bool) error {
+	if enable {
+		return v.action(ActionClimatisation, ActionClimatisationStart)
+	}
+	return v.action(ActionClimatisation, ActionClimatisationStop)
+}
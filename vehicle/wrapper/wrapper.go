@@ -3,14 +3,23 @@ package wrapper
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
 )
 
-// Wrapper wraps an api.Vehicle to capture initialization errors
+// Wrapper wraps an api.Vehicle to capture initialization errors.
+// If a factory is provided, the vehicle is re-created in the background
+// and swapped in once available.
+// Only the basic vehicle interface is delegated. Consumers pick up optional
+// vehicle features by replacing the wrapper with the live vehicle once available.
 type Wrapper struct {
+	mu        sync.RWMutex
+	vehicle   api.Vehicle // live vehicle after successful re-initialization
+	online    func()
 	err       error
+	name      string
 	title     string
 	icon      string
 	phases    int
@@ -20,6 +29,12 @@ type Wrapper struct {
 
 // New creates a new Vehicle
 func New(name string, other map[string]interface{}, err error) api.Vehicle {
+	return NewWithFactory(name, other, err, nil, nil)
+}
+
+// NewWithFactory creates a new Vehicle that is re-created using factory until it succeeds.
+// The optional online callback is invoked once the vehicle has become available.
+func NewWithFactory(name string, other map[string]interface{}, err error, factory func() (api.Vehicle, error), online func()) *Wrapper {
 	var cc struct {
 		Title    string
 		Icon     string
@@ -37,7 +52,9 @@ func New(name string, other map[string]interface{}, err error) api.Vehicle {
 	}
 
 	v := &Wrapper{
+		online:    online,
 		err:       fmt.Errorf("vehicle not available: %w", err),
+		name:      cc.Title,
 		title:     fmt.Sprintf("%s (offline)", cc.Title),
 		icon:      cc.Icon,
 		phases:    cc.Phases,
@@ -45,43 +62,92 @@ func New(name string, other map[string]interface{}, err error) api.Vehicle {
 		Features_: []api.Feature{api.Offline},
 	}
 
+	if factory != nil {
+		util.Reinit(util.NewLogger(name), factory, v.setVehicle)
+	}
+
 	return v
 }
 
+// setVehicle swaps in the live vehicle
+func (v *Wrapper) setVehicle(vehicle api.Vehicle) {
+	if vehicle.Title() == "" {
+		vehicle.SetTitle(v.name)
+	}
+
+	v.mu.Lock()
+	v.vehicle = vehicle
+	v.mu.Unlock()
+
+	if v.online != nil {
+		v.online()
+	}
+}
+
+// Live returns the live vehicle or nil if still offline
+func (v *Wrapper) Live() api.Vehicle {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.vehicle
+}
+
 var _ api.Vehicle = (*Wrapper)(nil)
 
 // Title implements the api.Vehicle interface
 func (v *Wrapper) Title() string {
+	if vv := v.Live(); vv != nil {
+		return vv.Title()
+	}
 	return v.title
 }
 
 // SetTitle implements the api.TitleSetter interface
 func (v *Wrapper) SetTitle(title string) {
+	if vv := v.Live(); vv != nil {
+		vv.SetTitle(title)
+		return
+	}
+	v.name = title
 	v.title = fmt.Sprintf("%s (unavailable)", title)
 }
 
 // Icon implements the api.Vehicle interface
 func (v *Wrapper) Icon() string {
+	if vv := v.Live(); vv != nil {
+		return vv.Icon()
+	}
 	return v.icon
 }
 
 // Capacity implements the api.Vehicle interface
 func (v *Wrapper) Capacity() float64 {
+	if vv := v.Live(); vv != nil {
+		return vv.Capacity()
+	}
 	return v.capacity
 }
 
 // Phases implements the api.Vehicle interface
 func (v *Wrapper) Phases() int {
+	if vv := v.Live(); vv != nil {
+		return vv.Phases()
+	}
 	return v.phases
 }
 
 // Identifiers implements the api.Vehicle interface
 func (v *Wrapper) Identifiers() []string {
+	if vv := v.Live(); vv != nil {
+		return vv.Identifiers()
+	}
 	return nil
 }
 
 // OnIdentified implements the api.Vehicle interface
 func (v *Wrapper) OnIdentified() api.ActionConfig {
+	if vv := v.Live(); vv != nil {
+		return vv.OnIdentified()
+	}
 	return api.ActionConfig{}
 }
 
@@ -89,12 +155,22 @@ var _ api.FeatureDescriber = (*Wrapper)(nil)
 
 // Features implements the api.FeatureDescriber interface
 func (v *Wrapper) Features() []api.Feature {
-	return []api.Feature{api.Offline}
+	vv := v.Live()
+	if vv == nil {
+		return []api.Feature{api.Offline}
+	}
+	if vv, ok := vv.(api.FeatureDescriber); ok {
+		return vv.Features()
+	}
+	return nil
 }
 
 var _ api.Battery = (*Wrapper)(nil)
 
 // Soc implements the api.Battery interface
 func (v *Wrapper) Soc() (float64, error) {
+	if vv := v.Live(); vv != nil {
+		return vv.Soc()
+	}
 	return 0, v.err
 }
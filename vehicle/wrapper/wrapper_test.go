@@ -0,0 +1,45 @@
+package wrapper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestReinit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	var online bool
+	w := NewWithFactory("car", map[string]interface{}{"capacity": 50}, errors.New("offline"), nil, func() {
+		online = true
+	})
+
+	// offline
+	_, err := w.Soc()
+	assert.Error(t, err)
+	assert.Equal(t, "Car (offline)", w.Title())
+	assert.Equal(t, 50.0, w.Capacity())
+	assert.True(t, slices.Contains(w.Features(), api.Offline))
+
+	// live vehicle swapped in
+	v := mock.NewMockVehicle(ctrl)
+	v.EXPECT().Title().Return("").AnyTimes()
+	v.EXPECT().SetTitle("Car")
+	v.EXPECT().Soc().Return(42.0, nil)
+	v.EXPECT().Capacity().Return(60.0)
+
+	w.setVehicle(v)
+
+	assert.True(t, online)
+
+	soc, err := w.Soc()
+	assert.NoError(t, err)
+	assert.Equal(t, 42.0, soc)
+	assert.Equal(t, 60.0, w.Capacity())
+	assert.False(t, slices.Contains(w.Features(), api.Offline))
+}